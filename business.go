@@ -0,0 +1,77 @@
+package moment
+
+import "time"
+
+// BusinessHours defines the Spans of time that something is open on each day of the week. The
+// zero value is closed on every day.
+type BusinessHours struct {
+	days [7][]Span
+}
+
+// Set replaces the Spans that are open on the given weekday. Each Span is placed on that
+// weekday in the location of its begin point, and a Span that crosses midnight stays open into
+// the following weekday. Weekdays outside of Sunday through Saturday are ignored.
+func (b *BusinessHours) Set(day time.Weekday, spans ...Span) {
+	if day < time.Sunday || day > time.Saturday {
+		return
+	}
+	b.days[day] = append([]Span(nil), spans...)
+}
+
+// Spans returns the Spans that are open on the given weekday
+func (b BusinessHours) Spans(day time.Weekday) []Span {
+	if day < time.Sunday || day > time.Saturday {
+		return nil
+	}
+	return b.days[day]
+}
+
+// IsOpen reports whether t falls within any of the Spans, placed on their weekdays. This
+// includes a Span set for the previous weekday that runs past midnight into t's day. An open
+// Span never ends once it has started, as for Span.Contains, so it is open at any time after
+// its start on an earlier day of its weekday.
+func (b BusinessHours) IsOpen(t time.Time) bool {
+	for weekday, spans := range b.days {
+		for _, s := range spans {
+			day := t.In(s.begin.Location())
+			for back := 0; ; back++ {
+				d := day.AddDate(0, 0, -back)
+				start, end := s.On(d)
+				if d.Weekday() == time.Weekday(weekday) && covers(start, end, t) {
+					return true
+				}
+				if !end.After(t) || s.Open() && back >= 7 {
+					break
+				}
+			}
+		}
+	}
+	return false
+}
+
+// NextOpen returns t if it is open as reported by IsOpen, and otherwise the next time any of
+// the Spans starts. It returns the zero time if no Spans are set.
+func (b BusinessHours) NextOpen(t time.Time) time.Time {
+	if b.IsOpen(t) {
+		return t
+	}
+	var next time.Time
+	for weekday, spans := range b.days {
+		for _, s := range spans {
+			day := t.In(s.begin.Location())
+			for ahead := 0; ahead <= 7; ahead++ {
+				d := day.AddDate(0, 0, ahead)
+				if d.Weekday() != time.Weekday(weekday) {
+					continue
+				}
+				if start := s.Start(d); start.After(t) {
+					if next.IsZero() || start.Before(next) {
+						next = start
+					}
+					break
+				}
+			}
+		}
+	}
+	return next
+}
@@ -0,0 +1,19 @@
+package moment
+
+import "fmt"
+
+// CronExpr returns a five field cron expression that runs daily at the point's time, such as
+// "30 9 * * *" for 09:30. Cron cannot express seconds, so they are dropped along with the
+// nanosecond and location; use CronExprChecked to detect this.
+func (p Point) CronExpr() string {
+	return fmt.Sprintf("%d %d * * *", p.minute, p.hour)
+}
+
+// CronExprChecked returns the same expression as CronExpr, but returns an error if the point
+// has a nonzero second or nanosecond that the expression cannot represent
+func (p Point) CronExprChecked() (string, error) {
+	if p.second != 0 || p.nanoSecond != 0 {
+		return "", fmt.Errorf("moment: point %v has sub-minute precision that cron cannot express", p)
+	}
+	return p.CronExpr(), nil
+}
@@ -0,0 +1,208 @@
+package moment
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MarshalJSON implements the json.Marshaler interface. The point is encoded as a string in
+// the form returned by String, such as "09:30:00 America/Chicago".
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The location name is resolved
+// with time.LoadLocation, caching the result for later calls, and an empty string decodes to
+// 00:00 UTC.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("moment: point must be a JSON string: %w", err)
+	}
+	point, err := parsePointString(s)
+	if err != nil {
+		return err
+	}
+	*p = point
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The text form is the same
+// string used by MarshalJSON. The zero point, like any point in UTC, encodes as
+// "00:00:00 UTC" and decodes back to the zero point.
+func (p Point) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, accepting the same forms
+// as UnmarshalJSON. Malformed input of any kind returns an error rather than panicking, and a
+// point that decodes successfully encodes back to text that decodes to the same clock and
+// location.
+func (p *Point) UnmarshalText(data []byte) error {
+	point, err := parsePointString(string(data))
+	if err != nil {
+		return err
+	}
+	*p = point
+	return nil
+}
+
+// pointBinaryVersion is the leading byte of the binary encoding of a Point
+const pointBinaryVersion byte = 1
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The encoding is a version
+// byte, a byte each for the hour, minute, and second, the nanosecond as a big-endian uint32,
+// and the location name prefixed by its length as a uvarint.
+func (p Point) MarshalBinary() ([]byte, error) {
+	name := p.Location().String()
+	data := make([]byte, 0, 8+binary.MaxVarintLen64+len(name))
+	data = append(data, pointBinaryVersion, byte(p.hour), byte(p.minute), byte(p.second))
+	data = binary.BigEndian.AppendUint32(data, uint32(p.nanoSecond))
+	data = binary.AppendUvarint(data, uint64(len(name)))
+	return append(data, name...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface
+func (p *Point) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("moment: decoding point: no data")
+	}
+	if data[0] != pointBinaryVersion {
+		return fmt.Errorf("moment: decoding point: unsupported version %d", data[0])
+	}
+	if len(data) < 8 {
+		return errors.New("moment: decoding point: data too short")
+	}
+	point, err := NewPointChecked(int(data[1]), int(data[2]), int(data[3]), int(binary.BigEndian.Uint32(data[4:8])))
+	if err != nil {
+		return fmt.Errorf("moment: decoding point: %w", err)
+	}
+	n, size := binary.Uvarint(data[8:])
+	if size <= 0 || uint64(len(data)-8-size) != n {
+		return errors.New("moment: decoding point: invalid location length")
+	}
+	loc, err := loadLocation(string(data[8+size:]))
+	if err != nil {
+		return fmt.Errorf("moment: decoding point: %w", err)
+	}
+	point.SetLocation(loc)
+	*p = point
+	return nil
+}
+
+// parsePointString parses a point in the form returned by String. A missing location name
+// means UTC.
+func parsePointString(s string) (Point, error) {
+	if s == "" {
+		return Point{}, nil
+	}
+	clock, name := s, "UTC"
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		clock, name = s[:i], s[i+1:]
+	}
+	p, err := ParsePoint("15:04:05", clock)
+	if err != nil {
+		return Point{}, err
+	}
+	loc, err := loadLocation(name)
+	if err != nil {
+		return Point{}, fmt.Errorf("moment: loading location of point %q: %w", s, err)
+	}
+	p.SetLocation(loc)
+	return p, nil
+}
+
+// spanJSON is the JSON representation of a Span
+type spanJSON struct {
+	Begin  Point  `json:"begin"`
+	Length string `json:"length"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. The Span is encoded as an object with
+// the begin point in the form used by Point and the length in the form returned by
+// time.Duration.String, such as {"begin":"09:00:00 UTC","length":"8h0m0s"}.
+func (s Span) MarshalJSON() ([]byte, error) {
+	return json.Marshal(spanJSON{
+		Begin:  s.begin,
+		Length: s.length.String(),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The length is parsed with
+// time.ParseDuration, and a missing field decodes as its zero value.
+func (s *Span) UnmarshalJSON(data []byte) error {
+	var v spanJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var length time.Duration
+	if v.Length != "" {
+		var err error
+		if length, err = time.ParseDuration(v.Length); err != nil {
+			return fmt.Errorf("moment: parsing span length: %w", err)
+		}
+	}
+	*s = NewSpan(v.Begin, length)
+	return nil
+}
+
+// csvDateLayout is the layout of the date field of a Span's CSV record
+const csvDateLayout = "2006-01-02"
+
+// CSVRecord returns the Span placed on the given day as the fields of a CSV record: the date
+// in the form "2006-01-02", the start and end times of day in the text form of a Point, such
+// as "09:00:00 UTC", and the length in the form returned by time.Duration.String. The end is
+// given in the location of the begin point, and the date is that of day as passed to On. An
+// open Span has no end or length, so both fields are empty.
+func (s Span) CSVRecord(day time.Time) []string {
+	start, end := s.On(day)
+	if s.Open() {
+		return []string{start.Format(csvDateLayout), s.begin.String(), "", ""}
+	}
+	return []string{
+		start.Format(csvDateLayout),
+		s.begin.String(),
+		PointFromTime(end.In(s.begin.Location())).String(),
+		s.length.String(),
+	}
+}
+
+// SpanFromCSVRecord parses a Span from a record in the form returned by CSVRecord. The Span is
+// built from the start and length, and an error is returned if the end does not match them on
+// the record's date. Empty end and length fields give an open Span.
+func SpanFromCSVRecord(record []string) (Span, error) {
+	if len(record) != 4 {
+		return Span{}, fmt.Errorf("moment: parsing span record: expected 4 fields, got %d", len(record))
+	}
+	day, err := time.Parse(csvDateLayout, record[0])
+	if err != nil {
+		return Span{}, fmt.Errorf("moment: parsing span record date: %w", err)
+	}
+	begin, err := parsePointString(record[1])
+	if err != nil {
+		return Span{}, fmt.Errorf("moment: parsing span record start: %w", err)
+	}
+	if record[2] == "" || record[3] == "" {
+		if record[2] != record[3] {
+			return Span{}, errors.New("moment: parsing span record: end and length must both be empty for an open span")
+		}
+		return NewOpenSpan(begin), nil
+	}
+	end, err := parsePointString(record[2])
+	if err != nil {
+		return Span{}, fmt.Errorf("moment: parsing span record end: %w", err)
+	}
+	length, err := time.ParseDuration(record[3])
+	if err != nil {
+		return Span{}, fmt.Errorf("moment: parsing span record length: %w", err)
+	}
+	s := NewSpan(begin, length)
+	if want := PointFromTime(s.End(day).In(begin.Location())); !end.SameClock(want) {
+		return Span{}, fmt.Errorf("moment: parsing span record: end %v does not match start and length, expected %v", end, want)
+	}
+	return s, nil
+}
@@ -0,0 +1,158 @@
+package moment
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPointBinaryRoundTrip(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	points := []Point{
+		{},
+		NewPoint(23, 59, 59, 999999999),
+		NewPoint(9, 30, 15, 1).WithLocation(chicago),
+		NewPoint(12).WithLocation(time.FixedZone("UTC", 0)),
+	}
+	for _, p := range points {
+		data, err := p.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%v.MarshalBinary() error: %v", p, err)
+		}
+		var got Point
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%x) error: %v", data, err)
+		}
+		if !got.SameClock(p) {
+			t.Errorf("binary round trip of %v = %v", p, got)
+		}
+	}
+}
+
+func TestPointUnmarshalBinaryErrors(t *testing.T) {
+	valid, err := NewPoint(9).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"unknown version", append([]byte{2}, valid[1:]...)},
+		{"truncated", valid[:5]},
+		{"hour out of range", append([]byte{1, 24}, valid[2:]...)},
+		{"missing location", valid[:8]},
+		{"short location", valid[:len(valid)-1]},
+		{"unknown location", append(append([]byte{}, valid[:8]...), 3, 'x', 'y', 'z')},
+	}
+	for _, tt := range tests {
+		var p Point
+		if err := p.UnmarshalBinary(tt.data); err == nil {
+			t.Errorf("%s: UnmarshalBinary(%x) = %v, want error", tt.name, tt.data, p)
+		}
+	}
+}
+
+func TestPointJSONNilLocation(t *testing.T) {
+	points := []Point{
+		{hour: 9, minute: 30},
+		NewPoint(9, 30),
+		NewPoint(9, 30).WithLocation(time.UTC),
+	}
+	for _, p := range points {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("marshaling %v: %v", p, err)
+		}
+		if string(data) != `"09:30:00 UTC"` {
+			t.Errorf("json.Marshal(%#v) = %s, want \"09:30:00 UTC\"", p, data)
+		}
+		var got Point
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("unmarshaling %s: %v", data, err)
+		}
+		if got != (Point{hour: 9, minute: 30}) {
+			t.Errorf("json round trip of %#v = %#v", p, got)
+		}
+	}
+	for _, data := range []string{`""`, `"00:00:00 UTC"`, `"00:00:00"`} {
+		var got Point
+		if err := json.Unmarshal([]byte(data), &got); err != nil || got != (Point{}) {
+			t.Errorf("json.Unmarshal(%s) = %#v, %v, want the zero Point", data, got, err)
+		}
+	}
+}
+
+func TestSpanCSVRoundTrip(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	spans := []Span{
+		NewSpan(NewPoint(9), 8*time.Hour),
+		NewSpan(NewPoint(22, 0, 0, 5), 26*time.Hour),
+		NewSpan(NewPoint().WithLocation(chicago), 5*time.Hour), // crosses the spring forward transition
+		NewSpan(NewPoint(1), 0),
+		NewOpenSpan(NewPoint(17)),
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, s := range spans {
+		if err := w.Write(s.CSVRecord(day)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != len(spans) {
+		t.Fatalf("read %d records, want %d", len(records), len(spans))
+	}
+	for i, record := range records {
+		got, err := SpanFromCSVRecord(record)
+		if err != nil {
+			t.Errorf("SpanFromCSVRecord(%q) error: %v", record, err)
+			continue
+		}
+		if !got.Equal(spans[i]) {
+			t.Errorf("SpanFromCSVRecord(%q) = %v, want %v", record, got, spans[i])
+		}
+	}
+
+	want := []string{"2024-03-10", "09:00:00 UTC", "17:00:00 UTC", "8h0m0s"}
+	if got := spans[0].CSVRecord(day); !slices.Equal(got, want) {
+		t.Errorf("CSVRecord = %q, want %q", got, want)
+	}
+	want = []string{"2024-03-10", "17:00:00 UTC", "", ""}
+	if got := NewOpenSpan(NewPoint(17)).CSVRecord(day); !slices.Equal(got, want) {
+		t.Errorf("CSVRecord of an open Span = %q, want %q", got, want)
+	}
+}
+
+func TestSpanFromCSVRecordErrors(t *testing.T) {
+	records := [][]string{
+		nil,
+		{"2024-03-10", "09:00:00 UTC", "17:00:00 UTC"},
+		{"10/03/2024", "09:00:00 UTC", "17:00:00 UTC", "8h0m0s"},
+		{"2024-03-10", "nine", "17:00:00 UTC", "8h0m0s"},
+		{"2024-03-10", "09:00:00 UTC", "17:00:00 Not/AZone", "8h0m0s"},
+		{"2024-03-10", "09:00:00 UTC", "17:00:00 UTC", "8 hours"},
+		{"2024-03-10", "09:00:00 UTC", "18:00:00 UTC", "8h0m0s"},
+		{"2024-03-10", "09:00:00 UTC", "", "8h0m0s"},
+		{"2024-03-10", "09:00:00 UTC", "17:00:00 UTC", ""},
+	}
+	for _, record := range records {
+		if s, err := SpanFromCSVRecord(record); err == nil {
+			t.Errorf("SpanFromCSVRecord(%q) = %v, want error", record, s)
+		}
+	}
+}
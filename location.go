@@ -0,0 +1,36 @@
+package moment
+
+import (
+	"sync"
+	"time"
+)
+
+// locationCache holds the locations loaded while decoding points, keyed by name
+var locationCache = struct {
+	sync.Mutex
+	locations map[string]*time.Location
+}{locations: map[string]*time.Location{}}
+
+// loadLocation returns the location with the given name like time.LoadLocation, reusing the
+// location from an earlier call when there is one
+func loadLocation(name string) (*time.Location, error) {
+	locationCache.Lock()
+	defer locationCache.Unlock()
+	if loc, ok := locationCache.locations[name]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locationCache.locations[name] = loc
+	return loc, nil
+}
+
+// ClearLocationCache discards the locations cached while decoding points, so that they are
+// loaded again from the time zone database the next time they are needed
+func ClearLocationCache() {
+	locationCache.Lock()
+	defer locationCache.Unlock()
+	locationCache.locations = map[string]*time.Location{}
+}
@@ -0,0 +1,15 @@
+package moment
+
+import "log/slog"
+
+// LogValue implements the slog.LogValuer interface, logging the point as a group of its
+// components and location name
+func (p Point) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("hour", p.hour),
+		slog.Int("minute", p.minute),
+		slog.Int("second", p.second),
+		slog.Int("nanosecond", p.nanoSecond),
+		slog.String("zone", p.Location().String()),
+	)
+}
@@ -1,6 +1,13 @@
 package moment
 
-import "time"
+import (
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
 
 const (
 	// HoursPerDay specifies the number of hours in a day
@@ -9,10 +16,42 @@ const (
 	MinutesPerHour = 60
 	// SecondsPerMinute specifies the number of seconds in a minute
 	SecondsPerMinute = 60
+	// NanosecondsPerSecond specifies the number of nanoseconds in a second
+	NanosecondsPerSecond = 1000000000
+	// SecondsPerHour specifies the number of seconds in an hour
+	SecondsPerHour = MinutesPerHour * SecondsPerMinute
+	// SecondsPerDay specifies the number of seconds in a day
+	SecondsPerDay = HoursPerDay * SecondsPerHour
+	// MinutesPerDay specifies the number of minutes in a day
+	MinutesPerDay = HoursPerDay * MinutesPerHour
+	// NanosecondsPerDay specifies the number of nanoseconds in a day
+	NanosecondsPerDay = SecondsPerDay * NanosecondsPerSecond
 )
 
+// dayLength is the duration of a day on a clock, disregarding daylight saving transitions
+const dayLength = NanosecondsPerDay * time.Nanosecond
+
+// ReferenceDate is the day points are placed on when an operation needs a concrete time but
+// no day is given, such as Before, After, Sub, and Format. It defaults to 1 January 2025, a
+// recent date on which named locations use the offsets they observe today rather than
+// historical ones such as local mean time. Locations in the northern hemisphere are on
+// standard time then, so 09:00 in America/New_York is equal to 06:00 in America/Los_Angeles,
+// while those in the southern hemisphere that observe daylight saving time are on it. Use
+// SetReferenceDate to compare points as they relate on another date.
+var ReferenceDate = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// SetReferenceDate sets ReferenceDate to midnight UTC on the date of day. It is not safe to
+// call concurrently with other functions in this package, so it is best called during
+// program initialization.
+func SetReferenceDate(day time.Time) {
+	ReferenceDate = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 // Point defines an abstract point in time. It does not include a day, month, or year but simply
-// a time of day
+// a time of day. Points are in UTC unless given another location, including the zero value,
+// which is 00:00 UTC. UTC is always stored as the absence of a location, so points in UTC are
+// identical however they were created, and the zero value survives encoding and decoding
+// unchanged.
 type Point struct {
 	hour       int
 	minute     int
@@ -24,15 +63,15 @@ type Point struct {
 // NewPoint creates a new time point with the given arguments. If no
 // arguments are given, the returned point represents the time 00:00 UTC. You can
 // provide up to 4 arguments in the order of "hour", "minute", "second", and "nanosecond".
-// Providing a fifth argument results in the default behavior and returns 00:00 UTC.
+// Any arguments beyond the fourth are ignored.
 func NewPoint(args ...int) Point {
-	p := Point{
-		location: time.Local,
+	var p Point
+	if len(args) > 4 {
+		args = args[:4]
 	}
-
 	switch len(args) {
 	case 4:
-		p.nanoSecond = args[3]
+		p.SetNanosecond(args[3])
 		fallthrough
 	case 3:
 		p.SetSecond(args[2])
@@ -46,17 +85,90 @@ func NewPoint(args ...int) Point {
 	return p
 }
 
-// SetLocation sets the point location
-func (p Point) SetLocation(loc *time.Location) {
+// PointFromTime creates a new time point from the time of day and location of t. It is the
+// inverse of On, so PointFromTime(p.On(day)) is equal to p on days without a daylight saving
+// transition at the time of p.
+func PointFromTime(t time.Time) Point {
+	p := Point{
+		hour:       t.Hour(),
+		minute:     t.Minute(),
+		second:     t.Second(),
+		nanoSecond: t.Nanosecond(),
+	}
+	p.SetLocation(t.Location())
+	return p
+}
+
+// PointFromDuration creates a new time point in UTC that is d after midnight. Durations of 24h
+// or more, and negative durations, wrap around the clock.
+func PointFromDuration(d time.Duration) Point {
+	p, _ := NewPoint().Add(d)
+	return p
+}
+
+// PointFromSecondsOfDay creates a new time point in UTC that is sec seconds after midnight.
+// Like PointFromDuration, values outside [0, SecondsPerDay) wrap around the clock.
+func PointFromSecondsOfDay(sec int32) Point {
+	return PointFromDuration(time.Duration(sec) * time.Second)
+}
+
+// NewPointChecked creates a new time point like NewPoint, but returns an error instead of
+// ignoring values that are out of range or arguments beyond the fourth.
+func NewPointChecked(args ...int) (Point, error) {
+	if len(args) > 4 {
+		return Point{}, fmt.Errorf("moment: too many arguments (%d), expected at most 4", len(args))
+	}
+	if err := checkFields(args...); err != nil {
+		return Point{}, err
+	}
+	return NewPoint(args...), nil
+}
+
+// NewPointIn creates a new time point like NewPoint in the location with the given name, as
+// loaded by time.LoadLocation. An error is returned if the location cannot be loaded.
+func NewPointIn(name string, args ...int) (Point, error) {
+	loc, err := loadLocation(name)
+	if err != nil {
+		return Point{}, fmt.Errorf("moment: loading location %q: %w", name, err)
+	}
+	return NewPoint(args...).WithLocation(loc), nil
+}
+
+// checkFields returns an error for the first of the given hour, minute, second, and
+// nanosecond values that is out of range
+func checkFields(values ...int) error {
+	names := []string{"hour", "minute", "second", "nanosecond"}
+	limits := []int{HoursPerDay, MinutesPerHour, SecondsPerMinute, NanosecondsPerSecond}
+	for i, v := range values {
+		if v < 0 || v >= limits[i] {
+			return fmt.Errorf("moment: %s %d out of range [0,%d)", names[i], v, limits[i])
+		}
+	}
+	return nil
+}
+
+// SetLocation sets the point location. A nil location is ignored.
+func (p *Point) SetLocation(loc *time.Location) {
 	if loc == nil {
 		// do nothing!
 		return
 	}
+	if loc == time.UTC {
+		loc = nil
+	}
 	p.location = loc
 }
 
+// SetNanosecond checks to ensure the given value is valid and then sets the "nanosecond" parameter
+func (p *Point) SetNanosecond(nsec int) {
+	if nsec < 0 || nsec >= NanosecondsPerSecond {
+		return
+	}
+	p.nanoSecond = nsec
+}
+
 // SetSecond checks to ensure the given value is valid and then sets the "second" parameter
-func (p Point) SetSecond(sec int) {
+func (p *Point) SetSecond(sec int) {
 	if sec < 0 || sec >= SecondsPerMinute {
 		return
 	}
@@ -64,7 +176,7 @@ func (p Point) SetSecond(sec int) {
 }
 
 // SetMinute checks to ensure the given value is valid and then sets the "minute" parameter
-func (p Point) SetMinute(min int) {
+func (p *Point) SetMinute(min int) {
 	if min < 0 || min >= MinutesPerHour {
 		return
 	}
@@ -72,19 +184,580 @@ func (p Point) SetMinute(min int) {
 }
 
 // SetHour checks to ensure the given value is valid and then sets the "hour" parameter
-func (p Point) SetHour(hr int) {
+func (p *Point) SetHour(hr int) {
 	if hr < 0 || hr >= HoursPerDay {
 		return
 	}
 	p.hour = hr
 }
 
-// On returns the concrete time that the point would occur on the day given
-func (p Point) On(day time.Time) time.Time {
+// Clone returns a copy of the point that can be changed with the setters without affecting
+// p. The location is shared between the two, which is safe because a *time.Location is never
+// modified once created.
+func (p Point) Clone() Point {
+	return p
+}
+
+// WithHour returns a copy of the point with the hour set, or unchanged if the hour is invalid
+func (p Point) WithHour(hr int) Point {
+	p.SetHour(hr)
+	return p
+}
+
+// WithMinute returns a copy of the point with the minute set, or unchanged if the minute is invalid
+func (p Point) WithMinute(min int) Point {
+	p.SetMinute(min)
+	return p
+}
+
+// WithSecond returns a copy of the point with the second set, or unchanged if the second is invalid
+func (p Point) WithSecond(sec int) Point {
+	p.SetSecond(sec)
+	return p
+}
+
+// WithNanosecond returns a copy of the point with the nanosecond set, or unchanged if the
+// nanosecond is invalid
+func (p Point) WithNanosecond(nsec int) Point {
+	p.SetNanosecond(nsec)
+	return p
+}
+
+// WithLocation returns a copy of the point with the location set, or unchanged if loc is nil
+func (p Point) WithLocation(loc *time.Location) Point {
+	p.SetLocation(loc)
+	return p
+}
+
+// Hour returns the hour of the point
+func (p Point) Hour() int {
+	return p.hour
+}
+
+// Minute returns the minute of the point
+func (p Point) Minute() int {
+	return p.minute
+}
+
+// Second returns the second of the point
+func (p Point) Second() int {
+	return p.second
+}
+
+// Nanosecond returns the nanosecond of the point
+func (p Point) Nanosecond() int {
+	return p.nanoSecond
+}
+
+// Location returns the location of the point. A point without a location is in UTC.
+func (p Point) Location() *time.Location {
 	if p.location == nil {
-		p.location = time.UTC
+		return time.UTC
+	}
+	return p.location
+}
+
+// Valid reports whether every component of the point is in range and its location can be
+// loaded by name, as checked by Validate
+func (p Point) Valid() bool {
+	return p.Validate() == nil
+}
+
+// Validate returns an error describing the first invalid component of the point. Points built
+// with NewPoint and the setters are always in range, but a struct literal may not be. The
+// location must also be loadable by its name with time.LoadLocation, so that the point
+// survives being encoded; a location from time.FixedZone only passes if its name happens to
+// be a known zone.
+func (p Point) Validate() error {
+	if err := checkFields(p.hour, p.minute, p.second, p.nanoSecond); err != nil {
+		return err
+	}
+	name := p.Location().String()
+	if _, err := loadLocation(name); err != nil {
+		return fmt.Errorf("moment: location %q cannot be loaded: %w", name, err)
+	}
+	return nil
+}
+
+// Normalize returns the point with out of range components carried into the next larger
+// one, the forgiving counterpart to Validate. A second of 75 becomes 15 with one minute added,
+// and a negative component borrows from the next larger one, so a minute of -1 becomes 59
+// with one hour taken away. The hour then wraps around the clock as it does for Add, and any
+// days crossed are discarded, so 23:59:75 normalizes to 00:00:15. The location is kept.
+func (p Point) Normalize() Point {
+	var over int
+	p.nanoSecond, over = carry(p.nanoSecond, NanosecondsPerSecond)
+	p.second, over = carry(p.second+over, SecondsPerMinute)
+	p.minute, over = carry(p.minute+over, MinutesPerHour)
+	p.hour, _ = carry(p.hour+over, HoursPerDay)
+	return p
+}
+
+// carry splits v into a value within [0,limit) and the number of limits carried over, which is
+// negative when v is
+func carry(v, limit int) (rest, over int) {
+	rest, over = v%limit, v/limit
+	if rest < 0 {
+		rest += limit
+		over--
+	}
+	return rest, over
+}
+
+// IsZero reports whether the point is midnight, 00:00:00.000000000. The location is not
+// considered, so midnight in any location is zero.
+func (p Point) IsZero() bool {
+	return p.hour == 0 && p.minute == 0 && p.second == 0 && p.nanoSecond == 0
+}
+
+// String returns the point formatted as "15:04:05.000000000" followed by the location name.
+// The fractional seconds are omitted when the nanosecond is zero.
+func (p Point) String() string {
+	return p.clock() + " " + p.Location().String()
+}
+
+// clock returns the time of day portion of String
+func (p Point) clock() string {
+	clock := fmt.Sprintf("%02d:%02d:%02d", p.hour, p.minute, p.second)
+	if p.nanoSecond != 0 {
+		clock += fmt.Sprintf(".%09d", p.nanoSecond)
+	}
+	return clock
+}
+
+// Format returns the point formatted according to the layout, as defined by time.Time.Format.
+// Layout elements that refer to the date (year, month, day) are rendered from ReferenceDate,
+// and so are the zone abbreviation and offset, which are those in effect on that date. With the
+// default ReferenceDate, 09:00 in America/New_York formats as "09:00 EST -05:00" with the
+// layout "15:04 MST -07:00" even while daylight saving time is observed.
+func (p Point) Format(layout string) string {
+	return p.On(ReferenceDate).Format(layout)
+}
+
+// FormatPrecision returns the time of day of the point as "15:04:05" followed by exactly the
+// given number of fractional second digits, truncating or padding with zeros as needed. The
+// number of digits is clamped to [0,9], and no fraction is written for 0.
+func (p Point) FormatPrecision(digits int) string {
+	if digits < 0 {
+		digits = 0
+	}
+	if digits > 9 {
+		digits = 9
+	}
+	layout := "15:04:05"
+	if digits > 0 {
+		layout += "." + strings.Repeat("0", digits)
+	}
+	return p.Format(layout)
+}
+
+// On returns the concrete time that the point would occur on the day given, as found by
+// time.Date. For a wall time skipped by a daylight saving transition, time.Date does not
+// guarantee the result, and in practice it comes out early by the length of the skip: 02:30
+// in America/New_York on 2024-03-10 gives 01:30 EST. ExistsOn detects such a day, and Next
+// moves the occurrence past the skip instead. A wall time that occurs twice, when the clocks
+// fall back, gives one of the two instants, and time.Date does not guarantee which; callers
+// that need a particular one should compare against UTCOffsetOn or adjust the result by the
+// transition themselves.
+func (p Point) On(day time.Time) time.Time {
+	return p.WithDate(day.Year(), day.Month(), day.Day())
+}
+
+// OnChecked returns the concrete time that the point would occur on the day given like On,
+// but returns an error instead of letting time.Date roll an out of range component, such as
+// the hour of a struct literal Point{hour: 30}, over into the next day. Unlike Validate, the
+// location is not checked.
+func (p Point) OnChecked(day time.Time) (time.Time, error) {
+	if err := checkFields(p.hour, p.minute, p.second, p.nanoSecond); err != nil {
+		return time.Time{}, err
+	}
+	return p.On(day), nil
+}
+
+// WithDate returns the concrete time that the point occurs on the date given, in the point's
+// location, as found by time.Date. The date is normalized in the same way, so day 32 of one
+// month is the first of the next.
+func (p Point) WithDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, p.hour, p.minute, p.second, p.nanoSecond, p.Location())
+}
+
+// UTCOffsetOn returns the offset east of UTC of the point's location when the point occurs on
+// the day given, which may differ from day to day because of daylight saving time
+func (p Point) UTCOffsetOn(day time.Time) time.Duration {
+	_, offset := p.On(day).Zone()
+	return time.Duration(offset) * time.Second
+}
+
+// Next returns the first time after the given instant that the point occurs, in the point's
+// location, counting at most one occurrence per calendar day. On a day where the point's wall
+// time is skipped by a daylight saving transition, the occurrence is moved forward by the
+// length of the skip, so 02:30 on a day the clocks jump from 02:00 to 03:00 occurs at 03:30.
+// On a day the clocks fall back, a repeated wall time occurs only at the instant On returns,
+// so Next from that instant or from between the two readings finds the following day.
+func (p Point) Next(after time.Time) time.Time {
+	day := after.In(p.Location())
+	t := p.resolve(day)
+	for !t.After(after) {
+		day = day.AddDate(0, 0, 1)
+		t = p.resolve(day)
+	}
+	return t
+}
+
+// UntilNext returns the time that will elapse from the given instant until the point next
+// occurs, as found by Next. The result is always positive, and it is measured as elapsed time
+// rather than a difference between clock readings, so it accounts for daylight saving
+// transitions in between; it can therefore exceed 24h on a day the clocks fall back, where the
+// repeated occurrence is not counted.
+func (p Point) UntilNext(from time.Time) time.Duration {
+	return p.Next(from).Sub(from)
+}
+
+// Previous returns the last time before the given instant that the point occurs, in the
+// point's location. Skipped wall times are handled as they are by Next.
+func (p Point) Previous(before time.Time) time.Time {
+	day := before.In(p.Location())
+	t := p.resolve(day)
+	for !t.Before(before) {
+		day = day.AddDate(0, 0, -1)
+		t = p.resolve(day)
+	}
+	return t
+}
+
+// Occurrences returns the times the point occurs in [start, end), once a day in the point's
+// location. Days on which the point's wall time is skipped by a daylight saving transition are
+// left out.
+func (p Point) Occurrences(start, end time.Time) []time.Time {
+	return slices.Collect(p.OccurrencesSeq(start, end))
+}
+
+// OccurrencesSeq returns an iterator over the same times as Occurrences, computing each one as
+// it is needed so that large ranges do not have to be held in memory
+func (p Point) OccurrencesSeq(start, end time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for day := start.In(p.Location()); ; day = day.AddDate(0, 0, 1) {
+			if !p.ExistsOn(day) {
+				continue
+			}
+			t := p.On(day)
+			if !t.Before(end) {
+				return
+			}
+			if !t.Before(start) && !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// OccurrencesStep returns the times in [start, end) that the point occurs, starting on the day
+// of start and repeating every step. When step is a whole number of days, the point is placed
+// on every so many calendar days, so it keeps its wall time across daylight saving
+// transitions, and days on which its wall time is skipped are left out as with Occurrences.
+// Any other step is added as elapsed time starting from the first occurrence at or after
+// start, found as with Next, so the wall time drifts across transitions. It returns nil if
+// step is not positive.
+func (p Point) OccurrencesStep(start, end time.Time, step time.Duration) []time.Time {
+	if step <= 0 {
+		return nil
+	}
+	var times []time.Time
+	if step%dayLength == 0 {
+		days := int(step / dayLength)
+		day := start.In(p.Location())
+		for i := 0; ; i += days {
+			d := day.AddDate(0, 0, i)
+			if !p.ExistsOn(d) {
+				continue
+			}
+			t := p.On(d)
+			if !t.Before(end) {
+				return times
+			}
+			if !t.Before(start) {
+				times = append(times, t)
+			}
+		}
+	}
+	for t := p.Next(start.Add(-1)); t.Before(end); t = t.Add(step) {
+		times = append(times, t)
+	}
+	return times
+}
+
+// ExistsOn reports whether the point's wall time occurs on the day given, which is not the
+// case when a daylight saving transition skips over it, such as 02:30 on a day the clocks jump
+// from 02:00 to 03:00. A wall time repeated when the clocks fall back exists, and On returns
+// one of its two instants.
+func (p Point) ExistsOn(day time.Time) bool {
+	t := p.On(day)
+	return t.Hour() == p.hour && t.Minute() == p.minute && t.Second() == p.second && t.Nanosecond() == p.nanoSecond
+}
+
+// resolve returns the time the point occurs on the day given like On, except that a wall time
+// skipped by a daylight saving transition is moved forward by the length of the skip
+func (p Point) resolve(day time.Time) time.Time {
+	t := p.On(day)
+	want := time.Date(day.Year(), day.Month(), day.Day(), p.hour, p.minute, p.second, p.nanoSecond, time.UTC)
+	got := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	if skipped := want.Sub(got); skipped > 0 {
+		t = t.Add(skipped)
+	}
+	return t
+}
+
+// OnAfter returns the concrete time that the point would occur on the date the given number
+// of days after day, which may be negative. The date is found with time.Time.AddDate, so the
+// point keeps its wall time across daylight saving transitions instead of drifting by the
+// hour the transition adds or removes.
+func (p Point) OnAfter(day time.Time, days int) time.Time {
+	return p.On(day.AddDate(0, 0, days))
+}
+
+// UTC returns the point with its location set to UTC and the clock left as it is. Unlike In,
+// which converts, this reinterprets the wall time: 09:00 in America/Chicago becomes 09:00 UTC,
+// not 14:00 or 15:00 UTC.
+func (p Point) UTC() Point {
+	return p.WithLocation(time.UTC)
+}
+
+// Local returns the point with its location set to time.Local and the clock left as it is.
+// Like UTC, this reinterprets the wall time rather than converting it as In does.
+func (p Point) Local() Point {
+	return p.WithLocation(time.Local)
+}
+
+// In returns the point converted to the location loc, using today's date to determine the
+// offsets involved, so the clock changes but the instant it names does not. Use UTC or Local
+// to keep the clock and change only the location. Use InOn when the date matters, such as
+// around daylight saving transitions.
+func (p Point) In(loc *time.Location) Point {
+	return p.InOn(loc, time.Now())
+}
+
+// InOn returns the point converted to the location loc, as it would be on the day given. A nil
+// location leaves the point unchanged.
+func (p Point) InOn(loc *time.Location, day time.Time) Point {
+	if loc == nil {
+		return p
+	}
+	return PointFromTime(p.On(day).In(loc))
+}
+
+// Add returns the point that is d after p on a clock, wrapping around midnight, along with
+// the number of days crossed in doing so. The day count is negative when a negative d wraps
+// back past midnight. The location is preserved.
+func (p Point) Add(d time.Duration) (Point, int) {
+	offset := p.SinceMidnight() + d
+	days := int(offset / dayLength)
+	offset %= dayLength
+	if offset < 0 {
+		offset += dayLength
+		days--
+	}
+	return p.withClock(offset), days
+}
+
+// Subtract returns the point that is d before p on a clock, wrapping around midnight, along
+// with the number of days crossed in doing so, which is negative when going back past
+// midnight. It is the same as Add with -d.
+func (p Point) Subtract(d time.Duration) (Point, int) {
+	return p.Add(-d)
+}
+
+// Offset returns the point that is d after p on a clock, like Add but without the day count.
+// It silently wraps around midnight, so 23:00 offset by 2h is 01:00.
+func (p Point) Offset(d time.Duration) Point {
+	q, _ := p.Add(d)
+	return q
+}
+
+// AddWithinDay returns the point that is d after p like Add, but returns an error instead of
+// wrapping if the result falls outside of [00:00, 24:00) on the same day
+func (p Point) AddWithinDay(d time.Duration) (Point, error) {
+	q, days := p.Add(d)
+	if days != 0 {
+		return p, fmt.Errorf("moment: adding %v to %v crosses midnight", d, p)
+	}
+	return q, nil
+}
+
+// SinceMidnight returns the time on the point's clock since midnight, so 09:30 gives 9h30m.
+// It does not account for daylight saving transitions on any particular day.
+func (p Point) SinceMidnight() time.Duration {
+	seconds := p.hour*SecondsPerHour + p.minute*SecondsPerMinute + p.second
+	return time.Duration(seconds)*time.Second + time.Duration(p.nanoSecond)
+}
+
+// ToSecondsOfDay returns the number of whole seconds on the point's clock since midnight,
+// ignoring the nanosecond and location. Together with Nanosecond it maps directly to types such
+// as google.type.TimeOfDay.
+func (p Point) ToSecondsOfDay() int32 {
+	return int32(p.hour*SecondsPerHour + p.minute*SecondsPerMinute + p.second)
+}
+
+// Truncate returns the point rounded down to a multiple of d since midnight, as defined by
+// time.Duration.Truncate. The point is returned unchanged if d is not positive.
+func (p Point) Truncate(d time.Duration) Point {
+	if d <= 0 {
+		return p
+	}
+	return p.withClock(p.SinceMidnight().Truncate(d))
+}
+
+// Round returns the point rounded to the nearest multiple of d since midnight, as defined by
+// time.Duration.Round. Rounding up to midnight wraps around to 00:00, discarding the day that
+// would be crossed, so 23:50 rounded to an hour is 00:00. The point is returned unchanged if
+// d is not positive.
+func (p Point) Round(d time.Duration) Point {
+	if d <= 0 {
+		return p
+	}
+	rounded, _ := p.Add(p.SinceMidnight().Round(d) - p.SinceMidnight())
+	return rounded
+}
+
+// QuantizeDown returns the point moved back to the previous multiple of interval since
+// midnight, or unchanged if it already is one. It is the same as Truncate.
+func (p Point) QuantizeDown(interval time.Duration) Point {
+	return p.Truncate(interval)
+}
+
+// QuantizeUp returns the point moved forward to the next multiple of interval since midnight,
+// or unchanged if it already is one. When the next multiple is at or past 24:00, the point
+// moves to 00:00 instead, where the following day's grid begins. The point is returned
+// unchanged if interval is not positive.
+func (p Point) QuantizeUp(interval time.Duration) Point {
+	if interval <= 0 {
+		return p
+	}
+	offset := p.SinceMidnight()
+	rem := offset % interval
+	if rem == 0 {
+		return p
+	}
+	up := offset + interval - rem
+	if up >= dayLength {
+		up = 0
+	}
+	return p.withClock(up)
+}
+
+// SlotOf returns the slot of a grid that t falls in, where the grid is made of back-to-back
+// slots of the given interval with one starting at anchor on t's day, in anchor's location. The
+// slot starts at or before t and ends after it, so with a 30m interval anchored at 09:00, 09:40
+// falls in the 09:30-10:00 slot. The grid extends backward from the anchor as well, so a time
+// before the anchor falls in a slot counted back from it, even one before that day's midnight;
+// each day has its own grid, which only lines up with the next day's when interval divides 24h.
+// It returns t as both start and end if interval is not positive.
+func SlotOf(t time.Time, interval time.Duration, anchor Point) (start, end time.Time) {
+	if interval <= 0 {
+		return t, t
+	}
+	origin := anchor.On(t.In(anchor.Location()))
+	offset := t.Sub(origin)
+	slots := offset / interval
+	if offset%interval < 0 {
+		slots--
 	}
-	return time.Date(day.Year(), day.Month(), day.Day(), p.hour, p.minute, p.second, p.nanoSecond, p.location)
+	start = origin.Add(slots * interval)
+	return start, start.Add(interval)
+}
+
+// maxStepPoints is the most points Step will return
+const maxStepPoints = 100000
+
+// Step returns the points from p to to, going forward around the clock by the given step and
+// wrapping past midnight when to is earlier in the day than p, so 23:30 to 00:30 by 15m gives
+// 23:30, 23:45, 00:00, 00:15, and 00:30. The last point is the final step that does not pass to,
+// which is to itself only when step divides the distance. The points are in p's location.
+// It returns an error if step is not positive or the range would need more than 100000 points.
+func (p Point) Step(to Point, step time.Duration) ([]Point, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("moment: step %v is not positive", step)
+	}
+	distance := to.Sub(p) % dayLength
+	if distance < 0 {
+		distance += dayLength
+	}
+	n := int64(distance/step) + 1
+	if n > maxStepPoints {
+		return nil, fmt.Errorf("moment: stepping from %v to %v by %v needs more than %d points", p, to, step, maxStepPoints)
+	}
+	points := make([]Point, n)
+	for i := range points {
+		points[i], _ = p.Add(time.Duration(i) * step)
+	}
+	return points, nil
+}
+
+// Sub returns the duration p-q. Both points are placed on the same reference date before
+// subtracting, so a difference in location offsets is taken into account.
+func (p Point) Sub(q Point) time.Duration {
+	return p.On(ReferenceDate).Sub(q.On(ReferenceDate))
+}
+
+// withClock returns p with its clock set to the given offset from midnight, which must be
+// within [0,24h)
+func (p Point) withClock(offset time.Duration) Point {
+	p.hour = int(offset / time.Hour)
+	p.minute = int(offset % time.Hour / time.Minute)
+	p.second = int(offset % time.Minute / time.Second)
+	p.nanoSecond = int(offset % time.Second)
+	return p
+}
+
+// OnAll returns the concrete times that each of the points would occur on the day given, in
+// the same order as the points
+func OnAll(day time.Time, points ...Point) []time.Time {
+	times := make([]time.Time, len(points))
+	for i, p := range points {
+		times[i] = p.On(day)
+	}
+	return times
+}
+
+// Before reports whether the point p occurs before q. Points are compared as instants on
+// a shared reference date, so points in different locations are compared after applying
+// each location's offset on that date; 09:00 in America/New_York is equal to 06:00 in
+// America/Los_Angeles.
+func (p Point) Before(q Point) bool {
+	return p.On(ReferenceDate).Before(q.On(ReferenceDate))
+}
+
+// After reports whether the point p occurs after q, compared in the same way as Before
+func (p Point) After(q Point) bool {
+	return p.On(ReferenceDate).After(q.On(ReferenceDate))
+}
+
+// Equal reports whether p and q represent the same instant of the day, compared in the same
+// way as Before. Use SameClock to also require the same clock reading and location.
+func (p Point) Equal(q Point) bool {
+	return p.On(ReferenceDate).Equal(q.On(ReferenceDate))
+}
+
+// Compare compares the points p and q in the same way as Before, returning -1 if p is before
+// q, +1 if p is after q, and 0 if they are equal
+func (p Point) Compare(q Point) int {
+	return p.On(ReferenceDate).Compare(q.On(ReferenceDate))
+}
+
+// PointKey returns the offset in nanoseconds of the point from ReferenceDate when placed on
+// it, which orders and identifies points in the same way as Compare and Equal. It suits
+// functions such as slices.SortStableFunc and slices.BinarySearchFunc, and can be used as a
+// map key. The key folds away the location: 09:00 in UTC and 03:00 in a location six hours
+// behind have the same key. For a point outside UTC the key may be negative or exceed a day.
+func PointKey(p Point) int64 {
+	return int64(p.On(ReferenceDate).Sub(ReferenceDate))
+}
+
+// SameClock reports whether p and q have the same hour, minute, second, nanosecond, and
+// location. Locations are compared by name rather than by pointer, so separately loaded
+// copies of the same location match.
+func (p Point) SameClock(q Point) bool {
+	return p.hour == q.hour && p.minute == q.minute && p.second == q.second &&
+		p.nanoSecond == q.nanoSecond && p.Location().String() == q.Location().String()
 }
 
 // Span defines a duration of time starting at an abstract moment in time
@@ -102,6 +775,50 @@ func NewSpan(begin Point, len time.Duration) Span {
 	return s
 }
 
+// openLength is the length of an open-ended Span
+const openLength = time.Duration(math.MaxInt64)
+
+// NewOpenSpan creates a Span that starts at begin and has no fixed end. Its length is the
+// largest time.Duration, so End and EndStrict return a time about 292 years after the start.
+// Placed on a day, it covers every instant from its start onward, so Contains reports true for
+// any time. Methods that depend on the length, such as Split, Divide, Midpoint, and
+// CoveredDuration, check for an open Span instead of using it.
+func NewOpenSpan(begin Point) Span {
+	return NewSpan(begin, openLength)
+}
+
+// Open reports whether the Span has no fixed end, as created by NewOpenSpan
+func (s Span) Open() bool {
+	return s.length == openLength
+}
+
+// NewSpanChecked creates a Span like NewSpan, but returns an error if the length is negative.
+// NewSpan accepts a negative length as given, leaving a Span that ends before it starts.
+func NewSpanChecked(begin Point, length time.Duration) (Span, error) {
+	if length < 0 {
+		return Span{}, fmt.Errorf("moment: span length %v is negative", length)
+	}
+	return NewSpan(begin, length), nil
+}
+
+// NewSpanFromPoints creates a Span that starts at begin and lasts until end, as measured by
+// Point.Sub. An end before begin is taken to be on the following day, so the Span crosses
+// midnight, while an end equal to begin gives a Span of zero length.
+func NewSpanFromPoints(begin, end Point) Span {
+	length := end.Sub(begin)
+	if length < 0 {
+		length += dayLength
+	}
+	return NewSpan(begin, length)
+}
+
+// FullDay creates a Span covering a whole day in loc, beginning at midnight with a length of
+// 24h. On a day with a daylight saving transition the day is not 24h long, so End lands an
+// hour before or after the following midnight; EndStrict lands on it exactly.
+func FullDay(loc *time.Location) Span {
+	return NewSpan(NewPoint().WithLocation(loc), dayLength)
+}
+
 // Start returns the "real" start time of a Span on the given day
 func (s Span) Start(day time.Time) time.Time {
 	return s.begin.On(day)
@@ -111,3 +828,48 @@ func (s Span) Start(day time.Time) time.Time {
 func (s Span) End(day time.Time) time.Time {
 	return s.Start(day).Add(s.length)
 }
+
+// EndStrict returns the end time of a Span on the given day found by advancing the clock of
+// the begin point by the Span's length, rather than adding the length as elapsed time like
+// End. The two differ when a daylight saving transition falls within the Span: for a
+// FullDay Span, EndStrict always returns the following midnight. An open Span ends where it
+// does for End.
+func (s Span) EndStrict(day time.Time) time.Time {
+	if s.Open() {
+		return s.End(day)
+	}
+	end, days := s.begin.Add(s.length)
+	return end.On(day.AddDate(0, 0, days))
+}
+
+// On returns both the "real" start and end times of a Span on the given day
+func (s Span) On(day time.Time) (start, end time.Time) {
+	start = s.Start(day)
+	return start, start.Add(s.length)
+}
+
+// Begin returns the point that the Span starts at
+func (s Span) Begin() Point {
+	return s.begin
+}
+
+// Duration returns the length of the Span, which is the largest time.Duration for an open Span
+func (s Span) Duration() time.Duration {
+	return s.length
+}
+
+// String returns the Span formatted as its start and end times of day followed by the
+// location name, such as "09:00:00-17:00:00 UTC". When the end falls on a different day than
+// the start, the number of days is appended to the end, as in "22:00:00-02:00:00+1d UTC". An
+// open Span has nothing after the dash, as in "17:00:00- UTC".
+func (s Span) String() string {
+	if s.Open() {
+		return s.begin.clock() + "- " + s.begin.Location().String()
+	}
+	end, days := s.begin.Add(s.length)
+	str := s.begin.clock() + "-" + end.clock()
+	if days != 0 {
+		str += fmt.Sprintf("%+dd", days)
+	}
+	return str + " " + s.begin.Location().String()
+}
@@ -0,0 +1,284 @@
+package moment
+
+import (
+	"testing"
+	"time"
+)
+
+// mustLoadLocation loads the named location, failing the test if it is not available
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("loading location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestSetters(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	var p Point
+	p.SetHour(9)
+	p.SetMinute(45)
+	p.SetSecond(30)
+	p.SetNanosecond(500)
+	p.SetLocation(chicago)
+
+	got := p.On(time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC))
+	want := time.Date(2024, time.January, 15, 9, 45, 30, 500, chicago)
+	if !got.Equal(want) || got.Location() != chicago {
+		t.Errorf("On after setters = %v, want %v", got, want)
+	}
+}
+
+func TestCompareAcrossLocations(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	losAngeles := mustLoadLocation(t, "America/Los_Angeles")
+	nine := NewPoint(9).WithLocation(newYork)
+	tests := []struct {
+		name    string
+		q       Point
+		compare int
+		sub     time.Duration
+	}{
+		{"same instant", NewPoint(6).WithLocation(losAngeles), 0, 0},
+		{"same instant in UTC", NewPoint(14), 0, 0},
+		{"earlier", NewPoint(5).WithLocation(losAngeles), 1, time.Hour},
+		{"later", NewPoint(9).WithLocation(losAngeles), -1, -3 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := nine.Compare(tt.q); got != tt.compare {
+			t.Errorf("%s: Compare(%v) = %d, want %d", tt.name, tt.q, got, tt.compare)
+		}
+		if got := nine.Equal(tt.q); got != (tt.compare == 0) {
+			t.Errorf("%s: Equal(%v) = %t", tt.name, tt.q, got)
+		}
+		if got := nine.Before(tt.q); got != (tt.compare < 0) {
+			t.Errorf("%s: Before(%v) = %t", tt.name, tt.q, got)
+		}
+		if got := nine.After(tt.q); got != (tt.compare > 0) {
+			t.Errorf("%s: After(%v) = %t", tt.name, tt.q, got)
+		}
+		if got := nine.Sub(tt.q); got != tt.sub {
+			t.Errorf("%s: Sub(%v) = %v, want %v", tt.name, tt.q, got, tt.sub)
+		}
+		if got := PointKey(nine) == PointKey(tt.q); got != (tt.compare == 0) {
+			t.Errorf("%s: keys of %v and %v equal = %t", tt.name, nine, tt.q, got)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	tests := []struct {
+		p      Point
+		layout string
+		want   string
+	}{
+		{NewPoint(9, 5, 7), "15:04:05", "09:05:07"},
+		{NewPoint(21, 30), "3:04PM", "9:30PM"},
+		{NewPoint(9).WithLocation(newYork), "15:04 MST -07:00", "09:00 EST -05:00"},
+		{NewPoint(9), "15:04 MST Z07:00", "09:00 UTC Z"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Format(tt.layout); got != tt.want {
+			t.Errorf("%v.Format(%q) = %q, want %q", tt.p, tt.layout, got, tt.want)
+		}
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	tests := []struct {
+		p    Point
+		want bool
+	}{
+		{Point{}, true},
+		{NewPoint(), true},
+		{NewPoint(0, 0, 0, 0), true},
+		{NewPoint().WithLocation(chicago), true},
+		{NewPoint(0, 0, 0, 1), false},
+		{NewPoint(12), false},
+	}
+	for _, tt := range tests {
+		if got := tt.p.IsZero(); got != tt.want {
+			t.Errorf("%v.IsZero() = %t, want %t", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestNewPointExtraArguments(t *testing.T) {
+	p := NewPoint(9, 30, 15, 500, 7, 8)
+	if want := NewPoint(9, 30, 15, 500); p != want {
+		t.Errorf("NewPoint with six arguments = %v, want %v", p, want)
+	}
+}
+
+func TestNanosecondRange(t *testing.T) {
+	for _, nsec := range []int{-1, NanosecondsPerSecond, 2000000000} {
+		p := NewPoint(9, 30, 15, 500)
+		p.SetNanosecond(nsec)
+		if p.Nanosecond() != 500 {
+			t.Errorf("SetNanosecond(%d) changed the nanosecond to %d", nsec, p.Nanosecond())
+		}
+		if got := NewPoint(9, 30, 15, nsec); got.Nanosecond() != 0 {
+			t.Errorf("NewPoint(9, 30, 15, %d) nanosecond = %d, want 0", nsec, got.Nanosecond())
+		}
+		if _, err := NewPointChecked(9, 30, 15, nsec); err == nil {
+			t.Errorf("NewPointChecked(9, 30, 15, %d) succeeded, want error", nsec)
+		}
+	}
+	p := NewPoint(9)
+	p.SetNanosecond(NanosecondsPerSecond - 1)
+	if p.Nanosecond() != NanosecondsPerSecond-1 {
+		t.Errorf("SetNanosecond(%d) = %d", NanosecondsPerSecond-1, p.Nanosecond())
+	}
+}
+
+func TestUTCOffsetOn(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	p := NewPoint(12).WithLocation(chicago)
+	tests := []struct {
+		day  time.Time
+		want time.Duration
+	}{
+		{time.Date(2024, time.March, 9, 0, 0, 0, 0, chicago), -6 * time.Hour},
+		{time.Date(2024, time.March, 10, 0, 0, 0, 0, chicago), -5 * time.Hour}, // clocks spring forward at 02:00
+		{time.Date(2024, time.November, 2, 0, 0, 0, 0, chicago), -5 * time.Hour},
+		{time.Date(2024, time.November, 3, 0, 0, 0, 0, chicago), -6 * time.Hour}, // clocks fall back at 02:00
+	}
+	for _, tt := range tests {
+		if got := p.UTCOffsetOn(tt.day); got != tt.want {
+			t.Errorf("UTCOffsetOn(%s) = %v, want %v", tt.day.Format("2006-01-02"), got, tt.want)
+		}
+	}
+	if got := NewPoint(1).WithLocation(chicago).UTCOffsetOn(tests[1].day); got != -6*time.Hour {
+		t.Errorf("01:00 before the transition: UTCOffsetOn = %v, want -6h", got)
+	}
+}
+
+func TestClone(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	original := NewPoint(9, 30).WithLocation(chicago)
+	clone := original.Clone()
+	clone.SetHour(17)
+	clone.SetMinute(0)
+	clone.SetLocation(time.UTC)
+	if want := NewPoint(9, 30).WithLocation(chicago); original != want {
+		t.Errorf("original changed to %v after changing its clone", original)
+	}
+	if want := NewPoint(17); clone != want {
+		t.Errorf("clone = %v, want %v", clone, want)
+	}
+}
+
+func TestQuantize(t *testing.T) {
+	tests := []struct {
+		p        Point
+		interval time.Duration
+		down, up Point
+	}{
+		{NewPoint(9, 7), 15 * time.Minute, NewPoint(9), NewPoint(9, 15)},
+		{NewPoint(9, 15), 15 * time.Minute, NewPoint(9, 15), NewPoint(9, 15)},
+		{NewPoint(23, 50), 45 * time.Minute, NewPoint(23, 15), NewPoint(0)},
+		{NewPoint(23, 59, 59, 1), time.Hour, NewPoint(23), NewPoint(0)},
+		{NewPoint(22), 7 * time.Hour, NewPoint(21), NewPoint(0)},
+		{NewPoint(9, 7), 0, NewPoint(9, 7), NewPoint(9, 7)},
+	}
+	for _, tt := range tests {
+		if got := tt.p.QuantizeDown(tt.interval); got != tt.down {
+			t.Errorf("%v.QuantizeDown(%v) = %v, want %v", tt.p, tt.interval, got, tt.down)
+		}
+		if got := tt.p.QuantizeUp(tt.interval); got != tt.up {
+			t.Errorf("%v.QuantizeUp(%v) = %v, want %v", tt.p, tt.interval, got, tt.up)
+		}
+	}
+}
+
+func TestOnAfterFallBack(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	p := NewPoint(9).WithLocation(chicago)
+	day := time.Date(2024, time.November, 2, 0, 0, 0, 0, chicago) // clocks fall back early on the 3rd
+	tests := []struct {
+		days int
+		want time.Time
+	}{
+		{0, time.Date(2024, time.November, 2, 9, 0, 0, 0, chicago)},
+		{1, time.Date(2024, time.November, 3, 9, 0, 0, 0, chicago)},
+		{3, time.Date(2024, time.November, 5, 9, 0, 0, 0, chicago)},
+		{-1, time.Date(2024, time.November, 1, 9, 0, 0, 0, chicago)},
+	}
+	for _, tt := range tests {
+		got := p.OnAfter(day, tt.days)
+		if !got.Equal(tt.want) || got.Hour() != 9 {
+			t.Errorf("OnAfter(%d) = %v, want %v", tt.days, got, tt.want)
+		}
+	}
+	// The day the clocks fall back is 25h long, so the same wall time the next day is 25h later
+	if got := p.OnAfter(day, 1); got.Sub(p.On(day)) != 25*time.Hour {
+		t.Errorf("OnAfter(1) is %v after On, want 25h", got.Sub(p.On(day)))
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	tests := []struct {
+		p        Point
+		d        time.Duration
+		want     Point
+		wantDays int
+	}{
+		{NewPoint(0, 30), 2 * time.Hour, NewPoint(22, 30), -1},
+		{NewPoint(9), time.Hour, NewPoint(8), 0},
+		{NewPoint(9), 0, NewPoint(9), 0},
+		{NewPoint(9), 57 * time.Hour, NewPoint(0), -2},
+		{NewPoint(23), -2 * time.Hour, NewPoint(1), 1},
+	}
+	for _, tt := range tests {
+		got, days := tt.p.Subtract(tt.d)
+		if got != tt.want || days != tt.wantDays {
+			t.Errorf("%v.Subtract(%v) = %v, %d, want %v, %d", tt.p, tt.d, got, days, tt.want, tt.wantDays)
+		}
+		back, backDays := got.Add(tt.d)
+		if back != tt.p || backDays != -days {
+			t.Errorf("%v.Add(%v) = %v, %d, want %v, %d", got, tt.d, back, backDays, tt.p, -days)
+		}
+	}
+}
+
+func TestOnSpringForward(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	p := NewPoint(2, 30).WithLocation(ny)
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, ny)
+	if p.ExistsOn(day) {
+		t.Errorf("%v.ExistsOn(%v) = true", p, day)
+	}
+	if got, want := p.On(day), time.Date(2024, time.March, 10, 6, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("%v.On(%v) = %v, want %v", p, day, got, want)
+	}
+	if got, want := p.Next(day), time.Date(2024, time.March, 10, 7, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("%v.Next(%v) = %v, want %v", p, day, got, want)
+	}
+}
+
+func TestNextFallBack(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	p := NewPoint(1, 30).WithLocation(ny)
+	first := time.Date(2024, time.November, 3, 5, 30, 0, 0, time.UTC) // 01:30 EDT
+	next := time.Date(2024, time.November, 4, 6, 30, 0, 0, time.UTC)  // 01:30 EST
+	tests := []struct {
+		from time.Time
+		want time.Time
+	}{
+		{first.Add(-time.Minute), first},
+		{first, next},
+		{first.Add(30 * time.Minute), next}, // 01:00 EST, before the repeated 01:30
+	}
+	for _, tt := range tests {
+		if got := p.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("%v.Next(%v) = %v, want %v", p, tt.from, got, tt.want)
+		}
+	}
+	if got := p.UntilNext(first); got != 25*time.Hour {
+		t.Errorf("%v.UntilNext(%v) = %v, want 25h", p, first, got)
+	}
+}
@@ -0,0 +1,168 @@
+package moment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ParsePoint parses a formatted string and returns the time of day it represents, as defined
+// by time.Parse. Any date elements in the layout are parsed but discarded. The point is in
+// the location given by the value if the layout includes a zone, and UTC otherwise.
+func ParsePoint(layout, value string) (Point, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return Point{}, fmt.Errorf("moment: parsing point %q: %w", value, err)
+	}
+	return PointFromTime(t), nil
+}
+
+// ParseSpan parses a Span written either as a start and end time of day, such as
+// "09:00-17:30", or as a start time of day and a duration in the form accepted by
+// time.ParseDuration, such as "09:00/8h". Times of day may include seconds and fractional
+// seconds and are in UTC. An end earlier than the start is taken to be on the following day,
+// so "22:00-02:00" is a 4h Span. A start followed by a dash and no end, such as "17:00-",
+// gives an open Span as created by NewOpenSpan.
+func ParseSpan(value string) (Span, error) {
+	if i := strings.IndexByte(value, '/'); i >= 0 {
+		begin, err := parseClock(value[:i])
+		if err != nil {
+			return Span{}, fmt.Errorf("moment: parsing span %q: %w", value, err)
+		}
+		length, err := time.ParseDuration(value[i+1:])
+		if err != nil {
+			return Span{}, fmt.Errorf("moment: parsing span %q: %w", value, err)
+		}
+		return NewSpan(begin, length), nil
+	}
+	if i := strings.IndexByte(value, '-'); i >= 0 {
+		begin, err := parseClock(value[:i])
+		if err != nil {
+			return Span{}, fmt.Errorf("moment: parsing span %q: %w", value, err)
+		}
+		if value[i+1:] == "" {
+			return NewOpenSpan(begin), nil
+		}
+		end, err := parseClock(value[i+1:])
+		if err != nil {
+			return Span{}, fmt.Errorf("moment: parsing span %q: %w", value, err)
+		}
+		return NewSpanFromPoints(begin, end), nil
+	}
+	return Span{}, fmt.Errorf("moment: parsing span %q: expected \"start-end\" or \"start/duration\"", value)
+}
+
+// ParseDaySpans parses a weekday followed by a comma-separated list of Spans, such as
+// "Mon 09:00-12:00,13:00-17:00", as used to configure BusinessHours. The weekday may be its
+// full English name or the first three letters of it, in any case, and is separated from the
+// Spans by any white space. Each Span is in a form accepted by ParseSpan.
+func ParseDaySpans(value string) (time.Weekday, []Span, error) {
+	name, list := strings.TrimSpace(value), ""
+	if i := strings.IndexFunc(name, unicode.IsSpace); i >= 0 {
+		name, list = name[:i], name[i:]
+	}
+	weekday, ok := parseWeekday(name)
+	if !ok {
+		return 0, nil, fmt.Errorf("moment: parsing day spans %q: unknown weekday %q", value, name)
+	}
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return 0, nil, fmt.Errorf("moment: parsing day spans %q: no spans after weekday", value)
+	}
+	var spans []Span
+	for _, field := range strings.Split(list, ",") {
+		s, err := ParseSpan(strings.TrimSpace(field))
+		if err != nil {
+			return 0, nil, fmt.Errorf("moment: parsing day spans %q: %w", value, err)
+		}
+		spans = append(spans, s)
+	}
+	return weekday, spans, nil
+}
+
+// parseWeekday returns the weekday with the given English name or three letter abbreviation,
+// ignoring case
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(name, d.String()) || strings.EqualFold(name, d.String()[:3]) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// parseClock parses a time of day written as "15:04" or "15:04:05", with optional fractional
+// seconds
+func parseClock(value string) (Point, error) {
+	layout := "15:04"
+	if strings.Count(value, ":") == 2 {
+		layout = "15:04:05"
+	}
+	return ParsePoint(layout, value)
+}
+
+// ParseSpanISO parses a Span from a start time of day, in a form accepted by ParseSpan, and a
+// length written as an ISO 8601 duration such as "PT8H30M". Only the hour, minute, and second
+// components are supported, and the smallest of them may have a fraction, as in "PT1.5H".
+// Durations with year, month, week, or day components are rejected, since their length
+// depends on the calendar.
+func ParseSpanISO(start string, isoDuration string) (Span, error) {
+	begin, err := parseClock(start)
+	if err != nil {
+		return Span{}, fmt.Errorf("moment: parsing span start %q: %w", start, err)
+	}
+	length, err := parseISODuration(isoDuration)
+	if err != nil {
+		return Span{}, err
+	}
+	return NewSpan(begin, length), nil
+}
+
+// parseISODuration parses the time components of an ISO 8601 duration
+func parseISODuration(value string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(value, "P")
+	if !ok {
+		return 0, fmt.Errorf("moment: parsing ISO 8601 duration %q: missing leading \"P\"", value)
+	}
+	date, clock, _ := strings.Cut(rest, "T")
+	if date != "" {
+		return 0, fmt.Errorf("moment: parsing ISO 8601 duration %q: date components are not supported", value)
+	}
+	if clock == "" {
+		return 0, fmt.Errorf("moment: parsing ISO 8601 duration %q: no time components", value)
+	}
+
+	// Rewrite the components in the form accepted by time.ParseDuration, checking that each
+	// unit appears at most once and in order.
+	const units = "HMS"
+	var goDuration strings.Builder
+	next, fraction := 0, false
+	for clock != "" {
+		i := strings.IndexAny(clock, units)
+		if i <= 0 || fraction {
+			return 0, fmt.Errorf("moment: parsing ISO 8601 duration %q: invalid time component", value)
+		}
+		number, unit := clock[:i], strings.IndexByte(units, clock[i])
+		if unit < next || !isDecimal(number) {
+			return 0, fmt.Errorf("moment: parsing ISO 8601 duration %q: invalid time component %q", value, clock[:i+1])
+		}
+		fraction = strings.Contains(number, ".")
+		goDuration.WriteString(number + strings.ToLower(units[unit:unit+1]))
+		next, clock = unit+1, clock[i+1:]
+	}
+	d, err := time.ParseDuration(goDuration.String())
+	if err != nil {
+		return 0, fmt.Errorf("moment: parsing ISO 8601 duration %q: %w", value, err)
+	}
+	return d, nil
+}
+
+// isDecimal reports whether s is a run of digits, optionally followed by a "." and more digits
+func isDecimal(s string) bool {
+	whole, fraction, found := strings.Cut(s, ".")
+	digits := func(s string) bool {
+		return s != "" && strings.Trim(s, "0123456789") == ""
+	}
+	return digits(whole) && (!found || digits(fraction))
+}
@@ -0,0 +1,157 @@
+package moment
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func FuzzParsePoint(f *testing.F) {
+	seeds := []string{
+		"",
+		"09:30:00",
+		"00:00:00 UTC",
+		"23:59:59.999999999 America/New_York",
+		"12:00:00 Local",
+		"24:00:00",
+		"9:30",
+		"09:30:00 Not/AZone",
+		"09:30:00  UTC",
+		"\x00",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if p, err := ParsePoint("15:04:05", s); err == nil {
+			checkPointTextRoundTrip(t, s, p)
+		}
+		var p Point
+		if err := p.UnmarshalText([]byte(s)); err == nil {
+			checkPointTextRoundTrip(t, s, p)
+		}
+	})
+}
+
+// checkPointTextRoundTrip fails the test unless the String form of p, parsed from s, parses
+// back to the same point
+func checkPointTextRoundTrip(t *testing.T, s string, p Point) {
+	t.Helper()
+	text := p.String()
+	var q Point
+	if err := q.UnmarshalText([]byte(text)); err != nil {
+		t.Fatalf("%q parsed to %v, whose text %q does not parse: %v", s, p, text, err)
+	}
+	if !q.SameClock(p) {
+		t.Fatalf("%q parsed to %v, whose text %q parses to %v", s, p, text, q)
+	}
+}
+
+func FuzzParseSpan(f *testing.F) {
+	seeds := []string{
+		"09:00-17:30",
+		"09:00/8h",
+		"22:00-02:00",
+		"09:00:00.5-09:00:01",
+		"09:00/-1h",
+		"17:00-",
+		"-",
+		"/",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		span, err := ParseSpan(s)
+		if err != nil {
+			return
+		}
+		data, err := json.Marshal(span)
+		if err != nil {
+			t.Fatalf("%q parsed to %v, which does not marshal: %v", s, span, err)
+		}
+		var back Span
+		if err := json.Unmarshal(data, &back); err != nil || !back.Equal(span) {
+			t.Fatalf("%q parsed to %v, whose JSON %s decodes to %v, %v", s, span, data, back, err)
+		}
+	})
+}
+
+func TestParseDaySpans(t *testing.T) {
+	morning := NewSpan(NewPoint(9), 3*time.Hour)
+	afternoon := NewSpan(NewPoint(13), 4*time.Hour)
+	tests := []struct {
+		value   string
+		weekday time.Weekday
+		spans   []Span
+	}{
+		{"Mon 09:00-12:00,13:00-17:00", time.Monday, []Span{morning, afternoon}},
+		{"monday 09:00-12:00, 13:00-17:00", time.Monday, []Span{morning, afternoon}},
+		{"MON\t09:00-12:00", time.Monday, []Span{morning}},
+		{"  Sat  \t 09:00/3h ", time.Saturday, []Span{morning}},
+		{"Sunday 13:00-17:00", time.Sunday, []Span{afternoon}},
+	}
+	for _, tt := range tests {
+		weekday, spans, err := ParseDaySpans(tt.value)
+		if err != nil {
+			t.Errorf("ParseDaySpans(%q) error: %v", tt.value, err)
+			continue
+		}
+		if weekday != tt.weekday || !slices.Equal(spans, tt.spans) {
+			t.Errorf("ParseDaySpans(%q) = %v, %v, want %v, %v", tt.value, weekday, spans, tt.weekday, tt.spans)
+		}
+	}
+}
+
+func TestParseDaySpansErrors(t *testing.T) {
+	tests := []struct {
+		value string
+		token string
+	}{
+		{"Mo 09:00-12:00", `"Mo"`},
+		{"Mon\t09:00-12:00,noon", `"noon"`},
+		{"Funday 09:00-12:00", `"Funday"`},
+		{"Mon", "no spans"},
+		{"", "unknown weekday"},
+	}
+	for _, tt := range tests {
+		_, _, err := ParseDaySpans(tt.value)
+		if err == nil {
+			t.Errorf("ParseDaySpans(%q) succeeded, want error", tt.value)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.token) {
+			t.Errorf("ParseDaySpans(%q) error %q does not mention %s", tt.value, err, tt.token)
+		}
+	}
+}
+
+func TestParseSpanISO(t *testing.T) {
+	tests := []struct {
+		duration string
+		want     time.Duration
+	}{
+		{"PT8H30M", 8*time.Hour + 30*time.Minute},
+		{"PT1.5H", 90 * time.Minute},
+		{"PT1H0.5M", time.Hour + 30*time.Second},
+		{"PT45S", 45 * time.Second},
+	}
+	for _, tt := range tests {
+		s, err := ParseSpanISO("09:00", tt.duration)
+		if err != nil {
+			t.Errorf("ParseSpanISO(%q) error: %v", tt.duration, err)
+			continue
+		}
+		if want := NewSpan(NewPoint(9), tt.want); s != want {
+			t.Errorf("ParseSpanISO(%q) = %v, want %v", tt.duration, s, want)
+		}
+	}
+
+	for _, duration := range []string{"PT1H.5M", "PT1..5M", "PT1.5.0H", "PT1.H", "PT.H", "P1D", "PT", "T1H", "PT1M1H", "PT1.5H30M"} {
+		if s, err := ParseSpanISO("09:00", duration); err == nil {
+			t.Errorf("ParseSpanISO(%q) = %v, want error", duration, s)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package moment
+
+import "sort"
+
+// PointSet is a collection of distinct points of the day. Points are the same when they are
+// equal as reported by Point.Equal, so 09:00 in America/New_York and 06:00 in
+// America/Los_Angeles are one member. The zero value is an empty set ready to use.
+// Membership is determined using ReferenceDate, so it must not be changed while a set is in
+// use.
+type PointSet struct {
+	points map[int64]Point
+}
+
+// Add adds the point to the set. If an equal point is already a member, the set is unchanged.
+func (s *PointSet) Add(p Point) {
+	if s.points == nil {
+		s.points = map[int64]Point{}
+	}
+	k := PointKey(p)
+	if _, ok := s.points[k]; !ok {
+		s.points[k] = p
+	}
+}
+
+// Remove removes the member equal to the point, if there is one
+func (s *PointSet) Remove(p Point) {
+	delete(s.points, PointKey(p))
+}
+
+// Contains reports whether a point equal to p is a member of the set
+func (s *PointSet) Contains(p Point) bool {
+	_, ok := s.points[PointKey(p)]
+	return ok
+}
+
+// Len returns the number of members in the set
+func (s *PointSet) Len() int {
+	return len(s.points)
+}
+
+// Slice returns the members of the set in order from earliest to latest, each as it was first
+// added
+func (s *PointSet) Slice() []Point {
+	points := make([]Point, 0, len(s.points))
+	for _, p := range s.points {
+		points = append(points, p)
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return PointKey(points[i]) < PointKey(points[j])
+	})
+	return points
+}
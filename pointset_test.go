@@ -0,0 +1,32 @@
+package moment
+
+import "testing"
+
+func TestPointSetMembership(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	losAngeles := mustLoadLocation(t, "America/Los_Angeles")
+	nineNewYork := NewPoint(9).WithLocation(newYork)
+	sixLosAngeles := NewPoint(6).WithLocation(losAngeles)
+
+	var s PointSet
+	s.Add(nineNewYork)
+	if !s.Contains(sixLosAngeles) {
+		t.Errorf("set containing %v does not contain %v", nineNewYork, sixLosAngeles)
+	}
+	if !s.Contains(NewPoint(14)) {
+		t.Errorf("set containing %v does not contain 14:00 UTC", nineNewYork)
+	}
+	s.Add(sixLosAngeles)
+	s.Add(NewPoint(10))
+	if s.Len() != 2 {
+		t.Errorf("Len = %d, want 2", s.Len())
+	}
+	got := s.Slice()
+	if len(got) != 2 || got[0] != NewPoint(10) || got[1] != nineNewYork {
+		t.Errorf("Slice = %v, want [10:00 UTC, %v]", got, nineNewYork)
+	}
+	s.Remove(NewPoint(14))
+	if s.Contains(nineNewYork) || s.Len() != 1 {
+		t.Errorf("after removing 14:00 UTC, set = %v", s.Slice())
+	}
+}
@@ -0,0 +1,75 @@
+package moment
+
+import "time"
+
+// Recurrence defines a point in time that repeats on certain days of the week. Its methods all
+// find occurrences as Point.Next and Point.Previous do, so on a day the point's wall time is
+// skipped by a daylight saving transition, it occurs moved forward by the length of the skip.
+type Recurrence struct {
+	point    Point
+	weekdays uint8
+}
+
+// NewRecurrence creates a Recurrence of the point on the given weekdays, which are taken in
+// the point's location. With no weekdays, the point recurs every day. Weekdays outside of
+// Sunday through Saturday are ignored.
+func NewRecurrence(p Point, weekdays ...time.Weekday) Recurrence {
+	r := Recurrence{point: p}
+	for _, day := range weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			continue
+		}
+		r.weekdays |= 1 << uint(day)
+	}
+	return r
+}
+
+// Point returns the point that recurs
+func (r Recurrence) Point() Point {
+	return r.point
+}
+
+// allows reports whether the Recurrence occurs on the given weekday
+func (r Recurrence) allows(day time.Weekday) bool {
+	return r.weekdays == 0 || r.weekdays&(1<<uint(day)) != 0
+}
+
+// Next returns the first occurrence after the given instant, as defined by Point.Next, that
+// falls on one of the Recurrence's weekdays
+func (r Recurrence) Next(after time.Time) time.Time {
+	t := r.point.Next(after)
+	for !r.allows(t.Weekday()) {
+		t = r.point.Next(t)
+	}
+	return t
+}
+
+// recurrenceSearchDays bounds how many days PreviousBefore looks back, which is enough to
+// reach every weekday once more than a full week
+const recurrenceSearchDays = 8
+
+// PreviousBefore returns the last occurrence before the given instant, as defined by
+// Point.Previous, that falls on one of the Recurrence's weekdays. The search looks back at
+// most recurrenceSearchDays occurrences, and ok is false if none of them is allowed, which
+// can only happen for a Recurrence whose weekdays were not set by NewRecurrence.
+func (r Recurrence) PreviousBefore(before time.Time) (t time.Time, ok bool) {
+	t = before
+	for i := 0; i < recurrenceSearchDays; i++ {
+		t = r.point.Previous(t)
+		if r.allows(t.Weekday()) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Between returns the occurrences in [start, end), as found by Next, so a day on which the
+// point's wall time is skipped by a daylight saving transition contributes the same moved
+// occurrence that Next returns rather than being left out as it is by Point.Occurrences
+func (r Recurrence) Between(start, end time.Time) []time.Time {
+	var times []time.Time
+	for t := r.Next(start.Add(-1)); t.Before(end); t = r.Next(t) {
+		times = append(times, t)
+	}
+	return times
+}
@@ -0,0 +1,29 @@
+package moment
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecurrenceSpringForward(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	r := NewRecurrence(NewPoint(2, 30).WithLocation(ny), time.Sunday)
+	skipped := time.Date(2024, time.March, 10, 3, 30, 0, 0, ny)
+	following := time.Date(2024, time.March, 17, 2, 30, 0, 0, ny)
+
+	start := time.Date(2024, time.March, 9, 0, 0, 0, 0, ny)
+	if got := r.Next(start); !got.Equal(skipped) {
+		t.Errorf("Next(%v) = %v, want %v", start, got, skipped)
+	}
+	if got, ok := r.PreviousBefore(following); !ok || !got.Equal(skipped) {
+		t.Errorf("PreviousBefore(%v) = %v, %t, want %v", following, got, ok, skipped)
+	}
+	end := time.Date(2024, time.March, 18, 0, 0, 0, 0, ny)
+	got := r.Between(start, end)
+	if len(got) != 2 || !got[0].Equal(skipped) || !got[1].Equal(following) {
+		t.Errorf("Between(%v, %v) = %v, want [%v %v]", start, end, got, skipped, following)
+	}
+	if got := r.Between(skipped, following); len(got) != 1 || !got[0].Equal(skipped) {
+		t.Errorf("Between(%v, %v) = %v, want [%v]", skipped, following, got, skipped)
+	}
+}
@@ -0,0 +1,60 @@
+package moment
+
+import (
+	"sync"
+	"time"
+)
+
+// SafePoint holds a Point that can be read and changed by multiple goroutines at once. The zero
+// value holds the zero Point and is ready to use. A SafePoint must not be copied after first
+// use.
+type SafePoint struct {
+	mu    sync.RWMutex
+	point Point
+}
+
+// Get returns the current point
+func (s *SafePoint) Get() Point {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.point
+}
+
+// Set replaces the current point
+func (s *SafePoint) Set(p Point) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.point = p
+}
+
+// update applies fn to the current point while holding the lock
+func (s *SafePoint) update(fn func(p *Point)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.point)
+}
+
+// SetHour sets the hour of the current point as Point.SetHour does
+func (s *SafePoint) SetHour(hr int) {
+	s.update(func(p *Point) { p.SetHour(hr) })
+}
+
+// SetMinute sets the minute of the current point as Point.SetMinute does
+func (s *SafePoint) SetMinute(min int) {
+	s.update(func(p *Point) { p.SetMinute(min) })
+}
+
+// SetSecond sets the second of the current point as Point.SetSecond does
+func (s *SafePoint) SetSecond(sec int) {
+	s.update(func(p *Point) { p.SetSecond(sec) })
+}
+
+// SetNanosecond sets the nanosecond of the current point as Point.SetNanosecond does
+func (s *SafePoint) SetNanosecond(nsec int) {
+	s.update(func(p *Point) { p.SetNanosecond(nsec) })
+}
+
+// SetLocation sets the location of the current point as Point.SetLocation does
+func (s *SafePoint) SetLocation(loc *time.Location) {
+	s.update(func(p *Point) { p.SetLocation(loc) })
+}
@@ -0,0 +1,523 @@
+package moment
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"time"
+)
+
+// Contains reports whether t falls within the half-open interval [start, end) of the Span.
+// A Span of zero length is treated as the single instant at its start, which it contains.
+// The Span is placed on t's day in the location of its begin point, so t may be in any
+// location, and on preceding days when it is long enough to run past midnight into t's day.
+// An overnight Span from 22:00 to 02:00 therefore contains 01:00 the next morning. An open
+// Span never ends once placed, so it contains every t: if t is before its start on t's day,
+// it is within the occurrence of the day before.
+func (s Span) Contains(t time.Time) bool {
+	_, _, ok := s.occurrence(t)
+	return ok
+}
+
+// ContainsPoint reports whether the time of day p falls within the Span on a clock, without
+// placing either on a date. The Span covers the times of day from its begin point up to, but
+// not including, the time its length later, wrapping around midnight, so a Span from 22:00 to
+// 02:00 contains 23:00 and 01:00. A Span of zero length contains only its begin point, and an
+// open Span contains every point, as Contains does every time. Points in different locations
+// are compared as by Point.Sub.
+func (s Span) ContainsPoint(p Point) bool {
+	if s.Open() {
+		return true
+	}
+	offset := p.Sub(s.begin) % dayLength
+	if offset < 0 {
+		offset += dayLength
+	}
+	return offset < s.length || offset == 0 && s.length == 0
+}
+
+// occurrence returns the interval of the Span that contains t, trying the Span placed on t's
+// day and then on each preceding day until it would end before t. An open Span is found on
+// t's day, or on the day before if t is before its start.
+func (s Span) occurrence(t time.Time) (start, end time.Time, ok bool) {
+	day := t.In(s.begin.Location())
+	if s.Open() {
+		if start, end = s.On(day); t.Before(start) {
+			start, end = s.On(day.AddDate(0, 0, -1))
+		}
+		return start, end, true
+	}
+	for back := 0; ; back++ {
+		start, end = s.On(day.AddDate(0, 0, -back))
+		if covers(start, end, t) {
+			return start, end, true
+		}
+		if !end.After(t) {
+			return start, end, false
+		}
+	}
+}
+
+// covers reports whether t is within [start, end), or is the instant itself when start and
+// end are equal
+func covers(start, end, t time.Time) bool {
+	return !t.Before(start) && t.Before(end) || t.Equal(start) && start.Equal(end)
+}
+
+// Overlaps reports whether the Spans s and other, placed on the given day, share any time.
+// Spans that only touch, where one ends exactly as the other starts, do not overlap, but a
+// Span of zero length overlaps a Span containing its instant, as defined by Contains. Both
+// Spans start on the given day, so one that crosses midnight is compared up to its end on the
+// following day.
+func (s Span) Overlaps(other Span, day time.Time) bool {
+	_, _, ok := s.Intersection(other, day)
+	return ok
+}
+
+// Adjacent reports whether the Spans s and other, placed on the given day, touch without
+// overlapping, so that one ends exactly as the other starts. The order of the Spans does not
+// matter. A Span of zero length at the start of another overlaps it, as defined by Overlaps,
+// and so is not adjacent to it.
+func (s Span) Adjacent(other Span, day time.Time) bool {
+	start, end := s.On(day)
+	otherStart, otherEnd := other.On(day)
+	if !end.Equal(otherStart) && !otherEnd.Equal(start) {
+		return false
+	}
+	return !s.Overlaps(other, day)
+}
+
+// Intersection returns the interval shared by the Spans s and other on the given day, which
+// runs from the later of their starts to the earlier of their ends. The result is only valid
+// if ok is true, which is the case when the Spans overlap as defined by Overlaps; when one of
+// them has zero length, the interval is that single instant.
+func (s Span) Intersection(other Span, day time.Time) (start, end time.Time, ok bool) {
+	sStart, sEnd := s.On(day)
+	otherStart, otherEnd := other.On(day)
+	start, end = sStart, sEnd
+	if otherStart.After(start) {
+		start = otherStart
+	}
+	if otherEnd.Before(end) {
+		end = otherEnd
+	}
+	if start.Equal(end) {
+		return start, end, covers(sStart, sEnd, start) && covers(otherStart, otherEnd, start)
+	}
+	return start, end, start.Before(end)
+}
+
+// OverlapDuration returns how long the Spans s and other overlap on the given day, using the
+// interval found by Intersection, or zero if they do not overlap. Two open Spans overlap
+// without end, which gives the largest time.Duration.
+func (s Span) OverlapDuration(other Span, day time.Time) time.Duration {
+	start, end, ok := s.Intersection(other, day)
+	if !ok {
+		return 0
+	}
+	if s.Open() && other.Open() {
+		return openLength
+	}
+	return end.Sub(start)
+}
+
+// ClampTo returns the part of s that lies within bounds on the given day, as found by
+// Intersection, in the location of s. It returns false if the Spans do not overlap. The
+// result is open only if both Spans are.
+func (s Span) ClampTo(bounds Span, day time.Time) (Span, bool) {
+	start, end, ok := s.Intersection(bounds, day)
+	if !ok {
+		return Span{}, false
+	}
+	begin := PointFromTime(start.In(s.begin.Location()))
+	if s.Open() && bounds.Open() {
+		return NewOpenSpan(begin), true
+	}
+	return NewSpan(begin, end.Sub(start)), true
+}
+
+// MergeSpans places the spans on the given day and combines those that overlap or touch,
+// returning the smallest set of Spans covering the same time, ordered by start. Each merged
+// Span begins at the time of day, and in the location, of the earliest start it covers. A
+// merged Span that runs past midnight keeps its full length, so placing it on the same day
+// again yields the same interval. A merged Span that covers an open Span is open.
+func MergeSpans(day time.Time, spans ...Span) []Span {
+	if len(spans) == 0 {
+		return nil
+	}
+	type interval struct {
+		start, end time.Time
+		open       bool
+	}
+	intervals := make([]interval, len(spans))
+	for i, s := range spans {
+		start, end := s.On(day)
+		intervals[i] = interval{start, end, s.Open()}
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+
+	merged := []interval{intervals[0]}
+	for _, next := range intervals[1:] {
+		last := &merged[len(merged)-1]
+		if next.start.After(last.end) {
+			merged = append(merged, next)
+			continue
+		}
+		if next.end.After(last.end) {
+			last.end = next.end
+		}
+		last.open = last.open || next.open
+	}
+
+	result := make([]Span, len(merged))
+	for i, m := range merged {
+		if m.open {
+			result[i] = NewOpenSpan(PointFromTime(m.start))
+			continue
+		}
+		result[i] = NewSpan(PointFromTime(m.start), m.end.Sub(m.start))
+	}
+	return result
+}
+
+// CoveredDuration returns how much of the time on the given day is covered by at least one
+// of the spans, counting time shared by overlapping Spans once. The spans are combined as by
+// MergeSpans, so two hour-long Spans overlapping by half an hour cover an hour and a half.
+// If any of the spans is open, the covered time has no end, which gives the largest
+// time.Duration.
+func CoveredDuration(day time.Time, spans []Span) time.Duration {
+	var total time.Duration
+	for _, s := range MergeSpans(day, spans...) {
+		if s.Open() {
+			return openLength
+		}
+		total += s.length
+	}
+	return total
+}
+
+// SpansCovering returns the spans that contain t, as defined by Contains, in their original
+// order. Each Span is placed relative to t on its own, so one that began the evening before
+// and runs past midnight covers t in the early morning.
+func SpansCovering(t time.Time, spans []Span) []Span {
+	var covering []Span
+	for _, s := range spans {
+		if s.Contains(t) {
+			covering = append(covering, s)
+		}
+	}
+	return covering
+}
+
+// SortSpans sorts the spans in place by their start on the given day, with Spans that start
+// at the same instant ordered from shortest to longest. The day is needed because Spans with
+// begin points in different locations only have an order once placed on a date.
+func SortSpans(day time.Time, spans []Span) {
+	sort.SliceStable(spans, func(i, j int) bool {
+		a, b := spans[i].Start(day), spans[j].Start(day)
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return spans[i].length < spans[j].length
+	})
+}
+
+// Split divides the Span into consecutive Spans of the given interval, with a shorter final
+// Span holding any remainder. It returns nil if the interval is not positive or the Span is
+// open. Parts that begin after midnight wrap around the clock like Point.Add, so they must be
+// placed on the following day to line up with the original Span.
+func (s Span) Split(interval time.Duration) []Span {
+	if interval <= 0 || s.Open() {
+		return nil
+	}
+	var parts []Span
+	for offset := time.Duration(0); offset < s.length; offset += interval {
+		begin, _ := s.begin.Add(offset)
+		length := interval
+		if remaining := s.length - offset; remaining < length {
+			length = remaining
+		}
+		parts = append(parts, NewSpan(begin, length))
+	}
+	return parts
+}
+
+// Clamp returns t limited to the Span placed on t's day: the start if t is before it, the end
+// if t is at or after the end, and t itself otherwise. The upper bound is inclusive here, unlike
+// Contains, so the end itself can be returned. If t falls within an occurrence of the Span
+// that started on an earlier day, as with an overnight Span, t is returned unchanged.
+func (s Span) Clamp(t time.Time) time.Time {
+	if _, _, ok := s.occurrence(t); ok {
+		return t
+	}
+	day := t.In(s.begin.Location())
+	start, end := s.On(day)
+	if t.Before(start) {
+		return start
+	}
+	return end
+}
+
+// Slots returns an iterator over consecutive intervals of the Span placed on the given day, each
+// of the given interval except for a shorter final one holding any remainder. The sequence is
+// empty if the interval is not positive or the Span is open.
+func (s Span) Slots(day time.Time, interval time.Duration) iter.Seq2[time.Time, time.Time] {
+	return func(yield func(time.Time, time.Time) bool) {
+		if interval <= 0 || s.Open() {
+			return
+		}
+		start, end := s.On(day)
+		for ; start.Before(end); start = start.Add(interval) {
+			slotEnd := start.Add(interval)
+			if slotEnd.After(end) {
+				slotEnd = end
+			}
+			if !yield(start, slotEnd) {
+				return
+			}
+		}
+	}
+}
+
+// SnapToSlot returns the start of the slot nearest to t, where slots are interval long and
+// begin at the start of the Span as they do for Slots. The Span is placed as it is by
+// Contains, and ok is false if it does not contain t or the interval is not positive. A time
+// exactly halfway between two slot starts snaps to the later one, unless that would be at or
+// past the end of the Span, in which case it snaps to the last slot start before the end.
+func (s Span) SnapToSlot(t time.Time, interval time.Duration) (time.Time, bool) {
+	if interval <= 0 {
+		return time.Time{}, false
+	}
+	start, end, ok := s.occurrence(t)
+	if !ok {
+		return time.Time{}, false
+	}
+	slot := (t.Sub(start) + interval/2) / interval
+	snapped := start.Add(slot * interval)
+	if !snapped.Before(end) && snapped.After(start) {
+		snapped = start.Add((end.Sub(start) - 1) / interval * interval)
+	}
+	return snapped, true
+}
+
+// Shift returns the Span with its begin point moved by d, wrapping around midnight like
+// Point.Add, and the same length and location
+func (s Span) Shift(d time.Duration) Span {
+	begin, _ := s.begin.Add(d)
+	return NewSpan(begin, s.length)
+}
+
+// MoveTo returns a Span with the given begin point and the same length as s. The location
+// of the new begin point is used, so the original location does not carry over.
+func (s Span) MoveTo(begin Point) Span {
+	return NewSpan(begin, s.length)
+}
+
+// AlignStart returns the Span with its begin point moved back to the top of its hour, as by
+// Point.Truncate, and its length grown to keep the same end. An open Span stays open.
+func (s Span) AlignStart() Span {
+	begin := s.begin.Truncate(time.Hour)
+	if s.Open() {
+		return NewOpenSpan(begin)
+	}
+	return NewSpan(begin, s.length+s.begin.Sub(begin))
+}
+
+// AlignStartKeepLength returns the Span with its begin point moved back to the top of its
+// hour like AlignStart, but keeping the same length, so the end moves back too
+func (s Span) AlignStartKeepLength() Span {
+	return s.MoveTo(s.begin.Truncate(time.Hour))
+}
+
+// Divide splits the Span into n consecutive Spans of equal length that together cover it
+// exactly. When the length does not divide evenly, the leftover nanoseconds are spread one
+// each over the first parts. Parts begin on the clock like those from Split. It returns an
+// error if n is not positive or the Span is open.
+func (s Span) Divide(n int) ([]Span, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("moment: cannot divide a span into %d parts", n)
+	}
+	if s.Open() {
+		return nil, fmt.Errorf("moment: cannot divide open span %v", s)
+	}
+	size, rem := s.length/time.Duration(n), s.length%time.Duration(n)
+	extra := time.Duration(1)
+	if rem < 0 {
+		extra, rem = -1, -rem
+	}
+	parts := make([]Span, n)
+	var offset time.Duration
+	for i := range parts {
+		length := size
+		if time.Duration(i) < rem {
+			length += extra
+		}
+		begin, _ := s.begin.Add(offset)
+		parts[i] = NewSpan(begin, length)
+		offset += length
+	}
+	return parts, nil
+}
+
+// Equal reports whether the Spans s and other have begin points with the same clock and
+// location, as reported by Point.SameClock, and the same length
+func (s Span) Equal(other Span) bool {
+	return s.begin.SameClock(other.begin) && s.length == other.length
+}
+
+// EqualInstant reports whether the Spans s and other start and end at the same instants when
+// placed on the given day, so Spans beginning at equivalent times in different locations are
+// equal
+func (s Span) EqualInstant(other Span, day time.Time) bool {
+	start, end := s.On(day)
+	otherStart, otherEnd := other.On(day)
+	return start.Equal(otherStart) && end.Equal(otherEnd)
+}
+
+// Then returns a Span that begins at the same point as s and lasts for the lengths of s and
+// next combined, as though next followed immediately after s. The begin point of next is
+// ignored, and the result is open if either Span is.
+func (s Span) Then(next Span) Span {
+	if s.Open() || next.Open() {
+		return NewOpenSpan(s.begin)
+	}
+	return NewSpan(s.begin, s.length+next.length)
+}
+
+// Extend returns the Span with its length increased by d, keeping the same begin point. An
+// open Span is returned unchanged.
+func (s Span) Extend(d time.Duration) Span {
+	if s.Open() {
+		return s
+	}
+	return NewSpan(s.begin, s.length+d)
+}
+
+// Shrink returns the Span with its length reduced by d, keeping the same begin point. The
+// length stops at zero rather than becoming negative, and an open Span is returned unchanged.
+func (s Span) Shrink(d time.Duration) Span {
+	if s.Open() {
+		return s
+	}
+	length := s.length - d
+	if length < 0 {
+		length = 0
+	}
+	return NewSpan(s.begin, length)
+}
+
+// Gaps returns the parts of bounds that are not covered by any of the busy Spans, with all of
+// them placed on the given day. The busy Spans are merged first, and any part of them outside
+// bounds is ignored. With no busy Spans, the result is bounds itself. Each gap begins in the
+// location of bounds; like Split, a gap that begins after midnight in a Span crossing
+// midnight must be placed on the following day to line up with bounds. If bounds is open, so
+// is the final gap, unless an open busy Span covers the rest of it.
+func Gaps(day time.Time, bounds Span, busy []Span) []Span {
+	loc := bounds.begin.Location()
+	cursor, limit := bounds.On(day)
+	var gaps []Span
+	for _, b := range MergeSpans(day, busy...) {
+		start, end := b.On(day)
+		if !start.Before(limit) {
+			break
+		}
+		if start.After(cursor) {
+			gaps = append(gaps, NewSpan(PointFromTime(cursor.In(loc)), start.Sub(cursor)))
+		}
+		if b.Open() {
+			// An open busy Span covers the rest of bounds, even when bounds is also open
+			// and begins after it
+			return gaps
+		}
+		if end.After(cursor) {
+			cursor = end
+		}
+	}
+	if cursor.Before(limit) {
+		begin := PointFromTime(cursor.In(loc))
+		if bounds.Open() {
+			gaps = append(gaps, NewOpenSpan(begin))
+		} else {
+			gaps = append(gaps, NewSpan(begin, limit.Sub(cursor)))
+		}
+	}
+	return gaps
+}
+
+// LengthParts returns the length of the Span as whole seconds and the remaining nanoseconds,
+// matching the fields of google.protobuf.Duration. Both parts have the sign of the length.
+func (s Span) LengthParts() (seconds int64, nanos int32) {
+	return int64(s.length / time.Second), int32(s.length % time.Second)
+}
+
+// SpanFromParts creates a Span beginning beginSec seconds after midnight, as given to
+// PointFromSecondsOfDay, with a length given as seconds and nanoseconds like LengthParts
+func SpanFromParts(beginSec int32, seconds int64, nanos int32) Span {
+	return NewSpan(PointFromSecondsOfDay(beginSec), time.Duration(seconds)*time.Second+time.Duration(nanos))
+}
+
+// Midpoint returns the instant halfway between the start and end of the Span on the given
+// day. Half of a length with an odd number of nanoseconds is rounded toward the start. An
+// open Span has no midpoint, so the result is its end, as returned by End.
+func (s Span) Midpoint(day time.Time) time.Time {
+	if s.Open() {
+		return s.End(day)
+	}
+	return s.Start(day).Add(s.length / 2)
+}
+
+// MidPoint returns the time of day halfway through the Span, rounded like Midpoint. It wraps
+// around midnight like Point.Add when the Span crosses midnight. An open Span has no
+// midpoint, so the result is its begin point.
+func (s Span) MidPoint() Point {
+	if s.Open() {
+		return s.begin
+	}
+	mid, _ := s.begin.Add(s.length / 2)
+	return mid
+}
+
+// Progress returns how far through the Span t is, from 0 at the start to 1 at the end. The
+// Span is placed as it is by Contains, so an overnight Span reports progress after midnight.
+// Otherwise it is placed on t's day, and the result is 0 if t is before it and 1 if t is at or
+// after its end. A Span of zero length is complete from its start onward, while an open Span
+// makes no progress toward its end and always reports 0.
+func (s Span) Progress(t time.Time) float64 {
+	if s.Open() {
+		return 0
+	}
+	start, _, ok := s.occurrence(t)
+	if !ok {
+		start = s.Start(t.In(s.begin.Location()))
+		if t.Before(start) {
+			return 0
+		}
+		return 1
+	}
+	if s.length <= 0 {
+		return 1
+	}
+	return float64(t.Sub(start)) / float64(s.length)
+}
+
+// Active reports whether the Span is in progress at now, placed as it is by Contains, along
+// with a duration relative to now. While active, the duration is the positive time remaining
+// until the Span ends. Otherwise the Span is placed on now's day: if it has not started yet,
+// the duration is the positive time until it starts, and if it has already ended, the duration
+// is the time since it ended, as zero or a negative value. An open Span is always active,
+// with the largest time.Duration remaining.
+func (s Span) Active(now time.Time) (bool, time.Duration) {
+	if s.Open() {
+		return true, openLength
+	}
+	if _, end, ok := s.occurrence(now); ok {
+		return true, end.Sub(now)
+	}
+	start, end := s.On(now.In(s.begin.Location()))
+	if now.Before(start) {
+		return false, start.Sub(now)
+	}
+	return false, end.Sub(now)
+}
@@ -0,0 +1,538 @@
+package moment
+
+import (
+	"testing"
+	"time"
+)
+
+// testDay is the Monday most span tests place their Spans on
+var testDay = time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+// at returns the time hour:minute on testDay, or on a following day for hours of 24 or more
+func at(hour, minute int) time.Time {
+	return testDay.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+}
+
+func TestSpanOverlaps(t *testing.T) {
+	workday := NewSpan(NewPoint(9), 8*time.Hour)
+	tests := []struct {
+		name  string
+		other Span
+		want  bool
+	}{
+		{"fully contained", NewSpan(NewPoint(12), time.Hour), true},
+		{"containing", NewSpan(NewPoint(8), 10*time.Hour), true},
+		{"partially overlapping start", NewSpan(NewPoint(8), 2*time.Hour), true},
+		{"partially overlapping end", NewSpan(NewPoint(16), 2*time.Hour), true},
+		{"identical", workday, true},
+		{"disjoint", NewSpan(NewPoint(18), time.Hour), false},
+		{"touching end", NewSpan(NewPoint(17), time.Hour), false},
+		{"touching start", NewSpan(NewPoint(8), time.Hour), false},
+	}
+	for _, tt := range tests {
+		if got := workday.Overlaps(tt.other, testDay); got != tt.want {
+			t.Errorf("%s: %v.Overlaps(%v) = %t, want %t", tt.name, workday, tt.other, got, tt.want)
+		}
+		if got := tt.other.Overlaps(workday, testDay); got != tt.want {
+			t.Errorf("%s: %v.Overlaps(%v) = %t, want %t", tt.name, tt.other, workday, got, tt.want)
+		}
+	}
+}
+
+func TestSortSpans(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	losAngeles := mustLoadLocation(t, "America/Los_Angeles")
+	chicagoNine := NewSpan(NewPoint(9).WithLocation(chicago), 2*time.Hour)        // 15:00 UTC
+	utcTen := NewSpan(NewPoint(10), time.Hour)                                    // 10:00 UTC
+	laEight := NewSpan(NewPoint(8).WithLocation(losAngeles), time.Hour)           // 16:00 UTC
+	utcFifteen := NewSpan(NewPoint(15), time.Hour)                                // 15:00 UTC, shorter
+	chicagoMidnight := NewSpan(NewPoint(0).WithLocation(chicago), 30*time.Minute) // 06:00 UTC
+
+	spans := []Span{chicagoNine, utcTen, laEight, utcFifteen, chicagoMidnight}
+	SortSpans(testDay, spans)
+	want := []Span{chicagoMidnight, utcTen, utcFifteen, chicagoNine, laEight}
+	for i := range want {
+		if spans[i] != want[i] {
+			t.Fatalf("SortSpans = %v, want %v", spans, want)
+		}
+	}
+}
+
+func TestSpanCrossingMidnight(t *testing.T) {
+	overnight := NewSpan(NewPoint(22), 4*time.Hour)
+	if got, want := overnight.End(testDay), at(26, 0); !got.Equal(want) {
+		t.Errorf("End = %v, want %v", got, want)
+	}
+	contains := []struct {
+		t    time.Time
+		want bool
+	}{
+		{at(21, 59), false},
+		{at(22, 0), true},
+		{at(23, 30), true},
+		{at(25, 0), true},  // 01:00 the next morning
+		{at(1, 0), true},   // 01:00 on testDay, from the previous evening
+		{at(26, 0), false}, // 02:00 the next morning, the end
+		{at(12, 0), false},
+	}
+	for _, tt := range contains {
+		if got := overnight.Contains(tt.t); got != tt.want {
+			t.Errorf("Contains(%v) = %t, want %t", tt.t, got, tt.want)
+		}
+	}
+	if !overnight.Overlaps(NewSpan(NewPoint(23, 30), time.Hour), testDay) {
+		t.Errorf("%v does not overlap 23:30 past midnight", overnight)
+	}
+}
+
+func TestNewSpanChecked(t *testing.T) {
+	tests := []struct {
+		length  time.Duration
+		wantErr bool
+	}{
+		{time.Hour, false},
+		{0, false},
+		{-time.Nanosecond, true},
+		{-time.Hour, true},
+	}
+	for _, tt := range tests {
+		s, err := NewSpanChecked(NewPoint(9), tt.length)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NewSpanChecked(09:00, %v) error = %v, want error %t", tt.length, err, tt.wantErr)
+			continue
+		}
+		if err == nil && s.Duration() != tt.length {
+			t.Errorf("NewSpanChecked(09:00, %v) length = %v", tt.length, s.Duration())
+		}
+	}
+	if s := NewSpan(NewPoint(9), -time.Hour); s.Duration() != -time.Hour {
+		t.Errorf("NewSpan(09:00, -1h) length = %v, want -1h", s.Duration())
+	}
+}
+
+func TestSpanClamp(t *testing.T) {
+	workday := NewSpan(NewPoint(9), 8*time.Hour)
+	overnight := NewSpan(NewPoint(22), 4*time.Hour)
+	tests := []struct {
+		name string
+		s    Span
+		t    time.Time
+		want time.Time
+	}{
+		{"before", workday, at(7, 0), at(9, 0)},
+		{"inside", workday, at(12, 30), at(12, 30)},
+		{"at start", workday, at(9, 0), at(9, 0)},
+		{"at end", workday, at(17, 0), at(17, 0)},
+		{"after", workday, at(20, 0), at(17, 0)},
+		{"overnight after midnight", overnight, at(1, 0), at(1, 0)},
+		{"overnight before", overnight, at(12, 0), at(22, 0)},
+	}
+	for _, tt := range tests {
+		if got := tt.s.Clamp(tt.t); !got.Equal(tt.want) {
+			t.Errorf("%s: %v.Clamp(%v) = %v, want %v", tt.name, tt.s, tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestSpanShift(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	tests := []struct {
+		s    Span
+		d    time.Duration
+		want Span
+	}{
+		{NewSpan(NewPoint(9), time.Hour), 30 * time.Minute, NewSpan(NewPoint(9, 30), time.Hour)},
+		{NewSpan(NewPoint(23), time.Hour), 2 * time.Hour, NewSpan(NewPoint(1), time.Hour)},
+		{NewSpan(NewPoint(1), time.Hour), -2 * time.Hour, NewSpan(NewPoint(23), time.Hour)},
+		{NewSpan(NewPoint(9).WithLocation(chicago), time.Hour), -10 * time.Hour, NewSpan(NewPoint(23).WithLocation(chicago), time.Hour)},
+	}
+	for _, tt := range tests {
+		if got := tt.s.Shift(tt.d); !got.Equal(tt.want) {
+			t.Errorf("%v.Shift(%v) = %v, want %v", tt.s, tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestGaps(t *testing.T) {
+	bounds := NewSpan(NewPoint(9), 8*time.Hour)
+	tests := []struct {
+		name string
+		busy []Span
+		want []Span
+	}{
+		{"nil busy", nil, []Span{bounds}},
+		{"before bounds", []Span{NewSpan(NewPoint(6), 2*time.Hour)}, []Span{bounds}},
+		{"after bounds", []Span{NewSpan(NewPoint(18), time.Hour)}, []Span{bounds}},
+		{"straddling the start", []Span{NewSpan(NewPoint(8), 2*time.Hour)}, []Span{NewSpan(NewPoint(10), 7*time.Hour)}},
+		{"straddling the end", []Span{NewSpan(NewPoint(16), 2*time.Hour)}, []Span{NewSpan(NewPoint(9), 7*time.Hour)}},
+		{"covering bounds", []Span{NewSpan(NewPoint(8), 10*time.Hour)}, nil},
+		{
+			"mixed",
+			[]Span{
+				NewSpan(NewPoint(20), time.Hour),
+				NewSpan(NewPoint(16), 2*time.Hour),
+				NewSpan(NewPoint(12), time.Hour),
+				NewSpan(NewPoint(8), 2*time.Hour),
+				NewSpan(NewPoint(6), time.Hour),
+			},
+			[]Span{NewSpan(NewPoint(10), 2*time.Hour), NewSpan(NewPoint(13), 3*time.Hour)},
+		},
+	}
+	for _, tt := range tests {
+		got := Gaps(testDay, bounds, tt.busy)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: Gaps = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: Gaps = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestNewSpanFromPoints(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	losAngeles := mustLoadLocation(t, "America/Los_Angeles")
+	tests := []struct {
+		name       string
+		begin, end Point
+		want       time.Duration
+	}{
+		{"same day", NewPoint(9), NewPoint(17, 30), 8*time.Hour + 30*time.Minute},
+		{"crossing midnight", NewPoint(22), NewPoint(2), 4 * time.Hour},
+		{"equal", NewPoint(9), NewPoint(9), 0},
+		{"same location", NewPoint(9).WithLocation(newYork), NewPoint(17).WithLocation(newYork), 8 * time.Hour},
+		{"different locations", NewPoint(9).WithLocation(newYork), NewPoint(9).WithLocation(losAngeles), 3 * time.Hour},
+	}
+	for _, tt := range tests {
+		s := NewSpanFromPoints(tt.begin, tt.end)
+		if s.Duration() != tt.want || s.Begin() != tt.begin {
+			t.Errorf("%s: NewSpanFromPoints(%v, %v) = %v, want length %v", tt.name, tt.begin, tt.end, s, tt.want)
+		}
+	}
+}
+
+func TestSpanContainsPoint(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	workday := NewSpan(NewPoint(9), 8*time.Hour)
+	overnight := NewSpan(NewPoint(22), 4*time.Hour)
+	tests := []struct {
+		name string
+		s    Span
+		p    Point
+		want bool
+	}{
+		{"before", workday, NewPoint(8, 59, 59, 999999999), false},
+		{"start", workday, NewPoint(9), true},
+		{"inside", workday, NewPoint(12), true},
+		{"end", workday, NewPoint(17), false},
+		{"after", workday, NewPoint(20), false},
+		{"other location inside", workday, NewPoint(9).WithLocation(chicago), true}, // 15:00 UTC
+		{"other location outside", workday, NewPoint(12).WithLocation(chicago), false},
+		{"wrapping start", overnight, NewPoint(22), true},
+		{"wrapping before midnight", overnight, NewPoint(23, 30), true},
+		{"wrapping midnight", overnight, NewPoint(0), true},
+		{"wrapping after midnight", overnight, NewPoint(1, 59), true},
+		{"wrapping end", overnight, NewPoint(2), false},
+		{"wrapping outside", overnight, NewPoint(12), false},
+		{"wrapping just before start", overnight, NewPoint(21, 59), false},
+		{"full day", FullDay(nil), NewPoint(23, 59), true},
+	}
+	for _, tt := range tests {
+		if got := tt.s.ContainsPoint(tt.p); got != tt.want {
+			t.Errorf("%s: %v.ContainsPoint(%v) = %t, want %t", tt.name, tt.s, tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestSpanActive(t *testing.T) {
+	workday := NewSpan(NewPoint(9), 8*time.Hour)
+	overnight := NewSpan(NewPoint(22), 4*time.Hour)
+	tests := []struct {
+		name       string
+		s          Span
+		now        time.Time
+		wantActive bool
+		want       time.Duration
+	}{
+		{"upcoming", workday, at(8, 30), false, 30 * time.Minute},
+		{"starting", workday, at(9, 0), true, 8 * time.Hour},
+		{"active", workday, at(16, 0), true, time.Hour},
+		{"ending", workday, at(17, 0), false, 0},
+		{"past", workday, at(18, 15), false, -75 * time.Minute},
+		{"overnight upcoming", overnight, at(21, 0), false, time.Hour},
+		{"overnight before midnight", overnight, at(23, 0), true, 3 * time.Hour},
+		{"overnight after midnight", overnight, at(25, 30), true, 30 * time.Minute},
+		{"overnight next morning", overnight, at(27, 0), false, 19 * time.Hour},
+	}
+	for _, tt := range tests {
+		active, d := tt.s.Active(tt.now)
+		if active != tt.wantActive || d != tt.want {
+			t.Errorf("%s: %v.Active(%v) = %t, %v, want %t, %v", tt.name, tt.s, tt.now, active, d, tt.wantActive, tt.want)
+		}
+	}
+}
+
+func TestZeroLengthSpan(t *testing.T) {
+	instant := NewSpan(NewPoint(10), 0)
+	for _, tt := range []struct {
+		t    time.Time
+		want bool
+	}{
+		{at(10, 0), true},
+		{at(10, 0).Add(time.Nanosecond), false},
+		{at(10, 0).Add(-time.Nanosecond), false},
+	} {
+		if got := instant.Contains(tt.t); got != tt.want {
+			t.Errorf("Contains(%v) = %t, want %t", tt.t, got, tt.want)
+		}
+	}
+	if !instant.ContainsPoint(NewPoint(10)) || instant.ContainsPoint(NewPoint(10, 0, 0, 1)) {
+		t.Errorf("ContainsPoint does not hold only %v", instant.Begin())
+	}
+
+	overlaps := []struct {
+		name  string
+		other Span
+		want  bool
+	}{
+		{"itself", instant, true},
+		{"span starting at the instant", NewSpan(NewPoint(10), time.Hour), true},
+		{"span around the instant", NewSpan(NewPoint(9), 2*time.Hour), true},
+		{"span ending at the instant", NewSpan(NewPoint(9), time.Hour), false},
+		{"other instant", NewSpan(NewPoint(11), 0), false},
+	}
+	for _, tt := range overlaps {
+		if got := instant.Overlaps(tt.other, testDay); got != tt.want {
+			t.Errorf("%s: Overlaps(%v) = %t, want %t", tt.name, tt.other, got, tt.want)
+		}
+		if got := tt.other.Overlaps(instant, testDay); got != tt.want {
+			t.Errorf("%s: %v.Overlaps(instant) = %t, want %t", tt.name, tt.other, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		t    time.Time
+		want float64
+	}{
+		{at(9, 0), 0},
+		{at(10, 0), 1},
+		{at(11, 0), 1},
+	} {
+		if got := instant.Progress(tt.t); got != tt.want {
+			t.Errorf("Progress(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestSpanSnapToSlot(t *testing.T) {
+	s := NewSpan(NewPoint(9), 50*time.Minute)
+	tests := []struct {
+		name   string
+		t      time.Time
+		want   time.Time
+		wantOK bool
+	}{
+		{"start", at(9, 0), at(9, 0), true},
+		{"just before midpoint", at(9, 7).Add(29 * time.Second), at(9, 0), true},
+		{"exact midpoint", at(9, 7).Add(30 * time.Second), at(9, 15), true},
+		{"after midpoint", at(9, 8), at(9, 15), true},
+		{"on a slot", at(9, 30), at(9, 30), true},
+		{"rounding past the end", at(9, 49), at(9, 45), true},
+		{"before the span", at(8, 59), time.Time{}, false},
+		{"at the end", at(9, 50), time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := s.SnapToSlot(tt.t, 15*time.Minute)
+		if ok != tt.wantOK || !got.Equal(tt.want) {
+			t.Errorf("%s: SnapToSlot(%v) = %v, %t, want %v, %t", tt.name, tt.t, got, ok, tt.want, tt.wantOK)
+		}
+	}
+	if _, ok := s.SnapToSlot(at(9, 10), 0); ok {
+		t.Errorf("SnapToSlot with a zero interval succeeded")
+	}
+}
+
+func TestSpanMoveTo(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	meeting := NewSpan(NewPoint(9), 90*time.Minute)
+	moved := meeting.MoveTo(NewPoint(14).WithLocation(chicago))
+	if moved.Duration() != 90*time.Minute {
+		t.Errorf("MoveTo length = %v, want 1h30m", moved.Duration())
+	}
+	day := time.Date(2024, time.January, 15, 0, 0, 0, 0, chicago)
+	if got, want := moved.Start(day), time.Date(2024, time.January, 15, 14, 0, 0, 0, chicago); !got.Equal(want) || got.Location() != chicago {
+		t.Errorf("Start = %v, want %v", got, want)
+	}
+}
+
+func TestOpenSpan(t *testing.T) {
+	open := NewOpenSpan(NewPoint(17))
+	if !open.Open() || NewSpan(NewPoint(17), time.Hour).Open() {
+		t.Fatalf("Open does not report only open Spans")
+	}
+	if got := open.String(); got != "17:00:00- UTC" {
+		t.Errorf("String = %q, want \"17:00:00- UTC\"", got)
+	}
+	if parsed, err := ParseSpan("17:00-"); err != nil || parsed != open {
+		t.Errorf("ParseSpan(\"17:00-\") = %v, %v, want %v", parsed, err, open)
+	}
+
+	for _, tt := range []time.Time{at(17, 0), at(23, 0), at(25, 0), at(32, 0), at(8, 0)} {
+		if !open.Contains(tt) {
+			t.Errorf("Contains(%v) = false", tt)
+		}
+	}
+	for _, p := range []Point{NewPoint(17), NewPoint(1), NewPoint(8)} {
+		if !open.ContainsPoint(p) {
+			t.Errorf("ContainsPoint(%v) = false", p)
+		}
+	}
+
+	farFuture := at(17, 0).AddDate(250, 0, 0)
+	if end := open.End(testDay); end.Before(farFuture) {
+		t.Errorf("End = %v, want after %v", end, farFuture)
+	}
+	if end := open.EndStrict(testDay); !end.Equal(open.End(testDay)) {
+		t.Errorf("EndStrict = %v, want %v", end, open.End(testDay))
+	}
+	if mid := open.Midpoint(testDay); !mid.Equal(open.End(testDay)) {
+		t.Errorf("Midpoint = %v, want %v", mid, open.End(testDay))
+	}
+	if mid := open.MidPoint(); mid != open.Begin() {
+		t.Errorf("MidPoint = %v, want %v", mid, open.Begin())
+	}
+	if got := open.Progress(at(20, 0)); got != 0 {
+		t.Errorf("Progress = %v, want 0", got)
+	}
+	if active, remaining := open.Active(at(25, 0)); !active || remaining != open.Duration() {
+		t.Errorf("Active = %t, %v, want true, %v", active, remaining, open.Duration())
+	}
+
+	if open.Split(time.Hour) != nil {
+		t.Errorf("Split of an open Span is not nil")
+	}
+	for start := range open.Slots(testDay, time.Hour) {
+		t.Errorf("Slots of an open Span yielded %v", start)
+		break
+	}
+	if _, err := open.Divide(2); err == nil {
+		t.Errorf("Divide of an open Span succeeded")
+	}
+	if !open.Extend(time.Hour).Open() || !open.Shrink(time.Hour).Open() || !NewSpan(NewPoint(9), time.Hour).Then(open).Open() {
+		t.Errorf("Extend, Shrink, or Then closed an open Span")
+	}
+	if got := NewOpenSpan(NewPoint(17, 40)).AlignStart(); got != open {
+		t.Errorf("AlignStart = %v, want %v", got, open)
+	}
+}
+
+func TestOpenSpanCollections(t *testing.T) {
+	open := NewOpenSpan(NewPoint(17))
+	early := NewSpan(NewPoint(1), time.Hour)
+
+	if got := CoveredDuration(testDay, []Span{early, open}); got != open.Duration() {
+		t.Errorf("CoveredDuration = %v, want %v", got, open.Duration())
+	}
+	if got := CoveredDuration(testDay, []Span{early}); got != time.Hour {
+		t.Errorf("CoveredDuration without the open Span = %v, want 1h", got)
+	}
+
+	merged := MergeSpans(testDay, NewSpan(NewPoint(16), 2*time.Hour), open, NewOpenSpan(NewPoint(18)), early)
+	if len(merged) != 2 || merged[0] != early || merged[1] != NewOpenSpan(NewPoint(16)) {
+		t.Errorf("MergeSpans = %v, want [%v %v]", merged, early, NewOpenSpan(NewPoint(16)))
+	}
+
+	gaps := Gaps(testDay, NewOpenSpan(NewPoint(9)), []Span{NewSpan(NewPoint(10), time.Hour)})
+	want := []Span{NewSpan(NewPoint(9), time.Hour), NewOpenSpan(NewPoint(11))}
+	if len(gaps) != 2 || gaps[0] != want[0] || gaps[1] != want[1] {
+		t.Errorf("Gaps = %v, want %v", gaps, want)
+	}
+	if gaps := Gaps(testDay, NewSpan(NewPoint(9), 10*time.Hour), []Span{open}); len(gaps) != 1 || gaps[0] != NewSpan(NewPoint(9), 8*time.Hour) {
+		t.Errorf("Gaps before an open busy Span = %v", gaps)
+	}
+	if gaps := Gaps(testDay, NewOpenSpan(NewPoint(9)), []Span{NewOpenSpan(NewPoint(8))}); len(gaps) != 0 {
+		t.Errorf("Gaps of an open Span covered by an earlier open busy Span = %v, want none", gaps)
+	}
+
+	if clamped, ok := open.ClampTo(NewOpenSpan(NewPoint(18)), testDay); !ok || clamped != NewOpenSpan(NewPoint(18)) {
+		t.Errorf("ClampTo another open Span = %v, %t", clamped, ok)
+	}
+	if clamped, ok := open.ClampTo(NewSpan(NewPoint(16), 2*time.Hour), testDay); !ok || clamped != NewSpan(NewPoint(17), time.Hour) {
+		t.Errorf("ClampTo a bounded Span = %v, %t", clamped, ok)
+	}
+	if got := open.OverlapDuration(NewOpenSpan(NewPoint(18)), testDay); got != open.Duration() {
+		t.Errorf("OverlapDuration of open Spans = %v, want %v", got, open.Duration())
+	}
+
+	// Once open on a Monday, the business never closes, so it is still open the Monday after
+	// before the Span starts again
+	var b BusinessHours
+	b.Set(time.Monday, open)
+	for _, tt := range []time.Time{at(16, 0), at(17, 0), at(25, 0), at(24*5, 0)} {
+		if !b.IsOpen(tt) {
+			t.Errorf("IsOpen(%v) = false", tt)
+		}
+	}
+}
+
+func TestSpanAlignStart(t *testing.T) {
+	chicago := mustLoadLocation(t, "America/Chicago")
+	meeting := NewSpan(NewPoint(9, 37, 12, 5).WithLocation(chicago), time.Hour)
+	nine := NewPoint(9).WithLocation(chicago)
+
+	aligned := meeting.AlignStart()
+	if aligned.Begin() != nine {
+		t.Errorf("AlignStart begin = %v, want %v", aligned.Begin(), nine)
+	}
+	day := time.Date(2024, time.January, 15, 0, 0, 0, 0, chicago)
+	if got, want := aligned.End(day), meeting.End(day); !got.Equal(want) {
+		t.Errorf("AlignStart end = %v, want %v", got, want)
+	}
+
+	kept := meeting.AlignStartKeepLength()
+	if kept != NewSpan(nine, time.Hour) {
+		t.Errorf("AlignStartKeepLength = %v, want %v", kept, NewSpan(nine, time.Hour))
+	}
+
+	if onTheHour := NewSpan(NewPoint(9), time.Hour); onTheHour.AlignStart() != onTheHour {
+		t.Errorf("AlignStart changed %v to %v", onTheHour, onTheHour.AlignStart())
+	}
+}
+
+func TestSpanAdjacent(t *testing.T) {
+	morning := NewSpan(NewPoint(9), 3*time.Hour)
+	tests := []struct {
+		name  string
+		other Span
+		want  bool
+	}{
+		{"touching after", NewSpan(NewPoint(12), time.Hour), true},
+		{"touching before", NewSpan(NewPoint(8), time.Hour), true},
+		{"overlapping", NewSpan(NewPoint(11), 2*time.Hour), false},
+		{"contained", NewSpan(NewPoint(10), time.Hour), false},
+		{"gap after", NewSpan(NewPoint(12, 1), time.Hour), false},
+		{"gap before", NewSpan(NewPoint(7), time.Hour), false},
+		{"instant at the end", NewSpan(NewPoint(12), 0), true},
+		{"instant at the start", NewSpan(NewPoint(9), 0), false},
+	}
+	for _, tt := range tests {
+		if got := morning.Adjacent(tt.other, testDay); got != tt.want {
+			t.Errorf("%s: %v.Adjacent(%v) = %t, want %t", tt.name, morning, tt.other, got, tt.want)
+		}
+		if got := tt.other.Adjacent(morning, testDay); got != tt.want {
+			t.Errorf("%s: %v.Adjacent(%v) = %t, want %t", tt.name, tt.other, morning, got, tt.want)
+		}
+	}
+
+	// Shifts that tile the day are each adjacent to the next
+	shifts := []Span{NewSpan(NewPoint(0), 8*time.Hour), NewSpan(NewPoint(8), 8*time.Hour), NewSpan(NewPoint(16), 8*time.Hour)}
+	for i := 1; i < len(shifts); i++ {
+		if !shifts[i-1].Adjacent(shifts[i], testDay) {
+			t.Errorf("%v is not adjacent to %v", shifts[i-1], shifts[i])
+		}
+	}
+}
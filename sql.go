@@ -0,0 +1,40 @@
+package moment
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// SQLIncludeLocation controls whether Point.Value appends the location name to the time of
+// day. It is false by default, which suits time of day columns such as PostgreSQL's "time".
+var SQLIncludeLocation = false
+
+// Value implements the driver.Valuer interface, producing a string such as
+// "15:04:05.999999999". The location name follows it when SQLIncludeLocation is set.
+func (p Point) Value() (driver.Value, error) {
+	v := p.Format("15:04:05.999999999")
+	if SQLIncludeLocation {
+		v += " " + p.Location().String()
+	}
+	return v, nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts strings and byte slices in the forms
+// produced by Value and MarshalText, and a time.Time, from which only the time of day and
+// location are taken. A NULL value scans as 00:00 UTC.
+func (p *Point) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*p = Point{}
+		return nil
+	case time.Time:
+		*p = PointFromTime(v)
+		return nil
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case []byte:
+		return p.UnmarshalText(v)
+	}
+	return fmt.Errorf("moment: cannot scan %T into a Point", src)
+}